@@ -0,0 +1,15 @@
+package main
+
+import (
+	"os"
+
+	"playwright-crawler/lurker"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🎯 메인 함수
+// ═══════════════════════════════════════════════════════════════════════════════
+
+func main() {
+	os.Exit(lurker.Main(os.Args[1:]))
+}
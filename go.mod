@@ -2,12 +2,17 @@ module playwright-crawler
 
 go 1.24.3
 
+require (
+	github.com/joho/godotenv v1.5.1
+	github.com/playwright-community/playwright-go v0.5200.0
+	golang.org/x/crypto v0.39.0
+	golang.org/x/term v0.32.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
 require (
 	github.com/deckarep/golang-set/v2 v2.7.0 // indirect
 	github.com/go-jose/go-jose/v3 v3.0.4 // indirect
 	github.com/go-stack/stack v1.8.1 // indirect
-	github.com/joho/godotenv v1.5.1 // indirect
-	github.com/playwright-community/playwright-go v0.5200.0 // indirect
 	golang.org/x/sys v0.33.0 // indirect
-	golang.org/x/term v0.32.0 // indirect
 )
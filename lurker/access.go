@@ -0,0 +1,394 @@
+package lurker
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🔐 접근 제어 (접근 키, 실패 잠금, access 명령)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🔐 접근 제어 검증 함수
+// 해시된 키와 상수 시간 비교로 확인하고, 연속으로 틀리면 실행 간에도 유지되는 잠금을 걸어요
+func checkAccess() bool {
+	if accessConfig.isPublic {
+		return true // 공개 모드인 경우 바로 통과
+	}
+
+	keys, err := loadAccessKeys()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return false
+	}
+	if len(keys) == 0 {
+		fmt.Println("⚠️ 비공개 모드이지만 사용할 수 있는 접근 키가 없어요")
+		fmt.Println("   PUBLIC_MODE=true로 설정하거나 'access add <이름>'으로 접근 키를 등록해주세요")
+		return false
+	}
+
+	printHeader("🔐 서비스 접근 인증")
+	fmt.Println("   이 서비스는 비공개 모드로 운영되고 있어요")
+	fmt.Println("   서비스를 이용하려면 접근 암호를 입력해주세요")
+	fmt.Println()
+
+	for {
+		lockout := loadAccessLockout()
+		if remaining := time.Until(lockout.LockedUntil); remaining > 0 {
+			fmt.Printf("   ⛔ 접근 암호를 여러 번 틀려서 잠겨 있어요. %v 후에 다시 시도해주세요\n", remaining.Round(time.Second))
+			return false
+		}
+
+		inputPassword := getPasswordInput(fmt.Sprintf("접근 암호를 입력하세요 (%d번 남음)", lockout.attemptsLeft(accessConfig.maxAttempts)))
+
+		if name, ok := matchAccessKey(keys, inputPassword); ok {
+			saveAccessLockout(accessLockout{})
+			fmt.Printf("   ✅ 인증 성공! (%s) 서비스를 시작할게요\n", name)
+			fmt.Println()
+			return true
+		}
+
+		if duration := lockout.recordFailure(time.Now(), accessConfig.maxAttempts, accessConfig.lockoutBase, accessConfig.lockoutMax); duration > 0 {
+			saveAccessLockout(lockout)
+			fmt.Printf("   ❌ 잘못된 암호에요. %v 동안 잠글게요\n", duration)
+			fmt.Println("   ❌ 접근이 거부되었어요. 프로그램을 종료할게요")
+			return false
+		}
+		saveAccessLockout(lockout)
+		fmt.Printf("   ❌ 잘못된 암호에요. %d번 더 시도할 수 있어요\n", lockout.attemptsLeft(accessConfig.maxAttempts))
+		fmt.Println()
+	}
+}
+
+// 🔐 접근 키를 보거나 바꾸기 전에 인증하는 함수
+// 사용할 수 있는 키가 하나도 없을 때(첫 키 등록)만 인증 없이 통과해요
+func checkAccessKeyManagement() bool {
+	if accessConfig.isPublic {
+		return true
+	}
+	keys, err := loadAccessKeys()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return false
+	}
+	if len(keys) == 0 {
+		return true
+	}
+	return checkAccess()
+}
+
+// 🔑 이름이 붙은 접근 키 (해시만 보관)
+type accessKey struct {
+	name      string
+	hash      string    // bcrypt 해시 (legacy 평문 키는 sha256 다이제스트)
+	expiresAt time.Time // 0이면 만료 없음
+	legacy    bool
+}
+
+func accessKeysPath() string {
+	if accessConfig.keysFile != "" {
+		return accessConfig.keysFile
+	}
+	return filepath.Join(stateDir(), "access_keys")
+}
+
+// 📖 접근 키 파일과 ACCESS_KEY에서 만료되지 않은 키를 읽는 함수
+// 파일 형식: 한 줄에 "이름:bcrypt해시[:만료일 YYYY-MM-DD]", #으로 시작하면 주석
+func loadAccessKeys() ([]accessKey, error) {
+	var keys []accessKey
+
+	if accessConfig.accessKey != "" {
+		if strings.HasPrefix(accessConfig.accessKey, "$2") {
+			keys = append(keys, accessKey{name: "ACCESS_KEY", hash: accessConfig.accessKey})
+		} else {
+			fmt.Println("⚠️ ACCESS_KEY가 평문이에요. 'access hash'로 만든 bcrypt 해시로 바꿔주세요")
+			digest := sha256.Sum256([]byte(accessConfig.accessKey))
+			keys = append(keys, accessKey{name: "ACCESS_KEY", hash: string(digest[:]), legacy: true})
+		}
+	}
+
+	data, err := os.ReadFile(accessKeysPath())
+	if errors.Is(err, fs.ErrNotExist) {
+		return keys, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("접근 키 파일을 읽을 수 없어요: %w", err)
+	}
+
+	for lineNumber, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, ":")
+		if len(fields) < 2 || len(fields) > 3 || !strings.HasPrefix(fields[1], "$2") {
+			fmt.Printf("⚠️ 접근 키 파일 %d번째 줄의 형식이 올바르지 않아 건너뛸게요\n", lineNumber+1)
+			continue
+		}
+		key := accessKey{name: fields[0], hash: fields[1]}
+		if len(fields) == 3 && fields[2] != "" {
+			expiresOn, err := time.ParseInLocation("2006-01-02", fields[2], time.Local)
+			if err != nil {
+				fmt.Printf("⚠️ 접근 키 '%s'의 만료일 형식이 올바르지 않아 건너뛸게요 (%s)\n", key.name, fields[2])
+				continue
+			}
+			// 만료일 당일까지 사용 가능
+			key.expiresAt = expiresOn.AddDate(0, 0, 1)
+		}
+		if !key.expiresAt.IsZero() && time.Now().After(key.expiresAt) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// 입력한 암호와 맞는 키의 이름을 찾는 함수 (bcrypt 비교, 평문 키는 다이제스트를 상수 시간 비교)
+func matchAccessKey(keys []accessKey, input string) (string, bool) {
+	for _, key := range keys {
+		if key.legacy {
+			digest := sha256.Sum256([]byte(input))
+			if subtle.ConstantTimeCompare(digest[:], []byte(key.hash)) == 1 {
+				return key.name, true
+			}
+			continue
+		}
+		if bcrypt.CompareHashAndPassword([]byte(key.hash), []byte(input)) == nil {
+			return key.name, true
+		}
+	}
+	return "", false
+}
+
+// ⛔ 실행 간에 유지되는 접근 실패 기록
+type accessLockout struct {
+	Failures    int       `json:"failures"`    // 마지막 성공 이후 연속 실패 횟수
+	Lockouts    int       `json:"lockouts"`    // 잠긴 횟수 (잠금 시간 계산용)
+	LockedUntil time.Time `json:"lockedUntil"` // 이 시각까지 잠김
+}
+
+// 다음 잠금까지 남은 시도 횟수
+func (lockout accessLockout) attemptsLeft(maxAttempts int) int {
+	return maxAttempts - lockout.Failures%maxAttempts
+}
+
+// 실패를 하나 기록하고, 이번 실패로 잠기면 잠금 시간을 돌려주는 함수 (잠기지 않으면 0)
+// maxAttempts번 틀릴 때마다 잠그고, 잠금 시간은 base부터 두 배씩 늘어나 max에서 멈춰요
+func (lockout *accessLockout) recordFailure(now time.Time, maxAttempts int, base, max time.Duration) time.Duration {
+	lockout.Failures++
+	if lockout.Failures%maxAttempts != 0 {
+		return 0
+	}
+	lockout.Lockouts++
+	// 시프트로 두 배를 만들면 넘쳐서 짧은 값이 될 수 있어서, 상한의 절반을 넘으면 바로 상한으로 멈춰요
+	duration := base
+	for i := 1; i < lockout.Lockouts && duration < max; i++ {
+		if duration > max/2 {
+			duration = max
+			break
+		}
+		duration *= 2
+	}
+	if duration > max || duration <= 0 {
+		duration = max
+	}
+	lockout.LockedUntil = now.Add(duration)
+	return duration
+}
+
+func accessLockoutPath() string {
+	return filepath.Join(stateDir(), "access_lockout.json")
+}
+
+func loadAccessLockout() accessLockout {
+	var lockout accessLockout
+	if data, err := os.ReadFile(accessLockoutPath()); err == nil {
+		json.Unmarshal(data, &lockout)
+	}
+	return lockout
+}
+
+func saveAccessLockout(lockout accessLockout) {
+	data, _ := json.Marshal(lockout)
+	if err := os.WriteFile(accessLockoutPath(), data, 0o600); err != nil {
+		fmt.Printf("   ⚠️ 접근 실패 기록을 저장하지 못했어요: %v\n", err)
+	}
+}
+
+// 🗝️ access add/list/remove/hash 명령을 처리하는 함수 (종료 코드 반환)
+func runAccessCommand(args []string) int {
+	if len(args) == 0 {
+		fmt.Println("사용법: access add <이름> [만료일 YYYY-MM-DD] | access list | access remove <이름> | access hash")
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "hash":
+		hash, err := promptAccessKeyHash()
+		if err != nil {
+			fmt.Printf("   ❌ %v\n", err)
+			return exitFailure
+		}
+		fmt.Println(hash)
+		return exitOK
+
+	case "list":
+		if !checkAccessKeyManagement() {
+			return exitFailure
+		}
+		data, err := os.ReadFile(accessKeysPath())
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			fmt.Printf("   ❌ 접근 키 파일을 읽을 수 없어요: %v\n", err)
+			return exitFailure
+		}
+		fmt.Printf("   📁 %s\n", accessKeysPath())
+		count := 0
+		for _, line := range strings.Split(string(data), "\n") {
+			fields := strings.Split(strings.TrimSpace(line), ":")
+			if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+				continue
+			}
+			expiry := "만료 없음"
+			if len(fields) == 3 && fields[2] != "" {
+				expiry = fields[2] + "까지"
+				if fields[2] < time.Now().Format("2006-01-02") {
+					expiry += ", 만료됨"
+				}
+			}
+			fmt.Printf("   • %s (%s)\n", fields[0], expiry)
+			count++
+		}
+		if count == 0 {
+			fmt.Println("   ℹ️ 등록된 접근 키가 없어요")
+		}
+		return exitOK
+
+	case "add", "remove":
+		if len(args) < 2 || strings.ContainsAny(args[1], ":#") {
+			fmt.Printf("사용법: access %s <이름> (이름에 ':'와 '#'은 쓸 수 없어요)\n", args[0])
+			return exitUsage
+		}
+	default:
+		fmt.Printf("   ❌ 알 수 없는 명령이에요: %s\n", args[0])
+		return exitUsage
+	}
+
+	if !checkAccessKeyManagement() {
+		return exitFailure
+	}
+
+	name := args[1]
+	data, err := os.ReadFile(accessKeysPath())
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		fmt.Printf("   ❌ 접근 키 파일을 읽을 수 없어요: %v\n", err)
+		return exitFailure
+	}
+
+	// 같은 이름의 기존 줄은 지우고 나머지는 그대로 유지
+	var lines []string
+	found := false
+	for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		if strings.SplitN(strings.TrimSpace(line), ":", 2)[0] == name {
+			found = true
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if args[0] == "remove" {
+		if !found {
+			fmt.Printf("   ❌ '%s' 접근 키가 없어요\n", name)
+			return exitFailure
+		}
+	} else {
+		expiry := ""
+		if len(args) >= 3 {
+			if _, err := time.Parse("2006-01-02", args[2]); err != nil {
+				fmt.Printf("   ❌ 만료일은 YYYY-MM-DD 형식이어야 해요: %s\n", args[2])
+				return exitUsage
+			}
+			expiry = args[2]
+		}
+		hash, err := promptAccessKeyHash()
+		if err != nil {
+			fmt.Printf("   ❌ %v\n", err)
+			return exitFailure
+		}
+		entry := name + ":" + hash
+		if expiry != "" {
+			entry += ":" + expiry
+		}
+		lines = append(lines, entry)
+	}
+
+	content := strings.Join(lines, "\n")
+	if content != "" {
+		content += "\n"
+	}
+	if err := os.WriteFile(accessKeysPath(), []byte(content), 0o600); err != nil {
+		fmt.Printf("   ❌ 접근 키 파일 저장 실패: %v\n", err)
+		return exitFailure
+	}
+	fmt.Printf("   ✅ 접근 키 파일을 저장했어요 (%s)\n", accessKeysPath())
+	return exitOK
+}
+
+// 접근 암호를 두 번 입력받아 bcrypt 해시로 만드는 함수
+func promptAccessKeyHash() (string, error) {
+	key := getPasswordInput("새 접근 암호를 입력하세요 (8자 이상)")
+	if len(key) < 8 {
+		return "", fmt.Errorf("접근 암호는 8자 이상이어야 해요")
+	}
+	if getPasswordInput("접근 암호를 한 번 더 입력하세요") != key {
+		return "", fmt.Errorf("두 암호가 서로 달라요")
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(key), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("해시 생성 실패: %w", err)
+	}
+	return string(hash), nil
+}
+
+func getYesNoInput(prompt string, defaultValue bool) bool {
+	defaultStr := "N"
+	if defaultValue {
+		defaultStr = "Y"
+	}
+
+	for {
+		input := getUserInput(prompt+" (Y/N)", defaultStr)
+		input = strings.ToUpper(strings.TrimSpace(input))
+
+		switch input {
+		case "Y":
+			return true
+		case "N":
+			return false
+		default:
+			fmt.Println("   ❌ Y 또는 N으로 입력해주세요.")
+			fmt.Println()
+		}
+	}
+}
+
+func getInputWithValidation(prompt, defaultValue string, validator func(string) bool, examples ...string) string {
+	for {
+		input := getUserInput(prompt, defaultValue, examples...)
+		if validator(input) {
+			return input
+		}
+	}
+}
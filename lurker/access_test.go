@@ -0,0 +1,121 @@
+package lurker
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAccessLockout(t *testing.T) {
+	const maxAttempts = 3
+	base, max := 30*time.Second, 4*time.Minute
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// 실패할 때마다 (잠금 시간, 남은 시도 횟수)
+	tests := []struct {
+		lockedFor    time.Duration
+		attemptsLeft int
+	}{
+		{0, 2}, {0, 1}, {30 * time.Second, 3}, // 첫 잠금: 기본 시간
+		{0, 2}, {0, 1}, {time.Minute, 3}, // 두 번째 잠금: 두 배
+		{0, 2}, {0, 1}, {2 * time.Minute, 3},
+		{0, 2}, {0, 1}, {4 * time.Minute, 3},
+		{0, 2}, {0, 1}, {4 * time.Minute, 3}, // 상한에서 멈춤
+	}
+
+	var lockout accessLockout
+	if got := lockout.attemptsLeft(maxAttempts); got != maxAttempts {
+		t.Fatalf("처음 남은 시도 = %d, want %d", got, maxAttempts)
+	}
+	for i, tt := range tests {
+		got := lockout.recordFailure(now, maxAttempts, base, max)
+		if got != tt.lockedFor {
+			t.Errorf("%d번째 실패: 잠금 %v, want %v", i+1, got, tt.lockedFor)
+		}
+		if got > 0 && !lockout.LockedUntil.Equal(now.Add(got)) {
+			t.Errorf("%d번째 실패: 잠금 해제 시각 %v, want %v", i+1, lockout.LockedUntil, now.Add(got))
+		}
+		if left := lockout.attemptsLeft(maxAttempts); left != tt.attemptsLeft {
+			t.Errorf("%d번째 실패 후 남은 시도 %d, want %d", i+1, left, tt.attemptsLeft)
+		}
+	}
+}
+
+func TestAccessLockoutCap(t *testing.T) {
+	// 잠금 횟수가 많아져도 두 배씩 늘어나다 상한에서 멈추고, 넘쳐서 짧아지면 안 돼요
+	tests := []struct {
+		base, max time.Duration
+		lockouts  int // 이번 실패 전까지 잠긴 횟수
+		want      time.Duration
+	}{
+		{30 * time.Second, time.Hour, 5, 16 * time.Minute},
+		{30 * time.Second, time.Hour, 6, 32 * time.Minute},
+		{30 * time.Second, time.Hour, 7, time.Hour}, // 64분이 되기 전에 상한
+		{30 * time.Second, time.Hour, 8, time.Hour},
+		{30 * time.Second, 45 * time.Minute, 6, 32 * time.Minute},
+		{30 * time.Second, 45 * time.Minute, 7, 45 * time.Minute},
+		{30 * time.Second, time.Hour, 33, time.Hour}, // 시프트였다면 넘치는 횟수
+		{30 * time.Second, time.Hour, 63, time.Hour},
+		{30 * time.Second, time.Hour, 64, time.Hour},
+		{30 * time.Second, time.Hour, 65, time.Hour},
+		{30 * time.Second, time.Hour, 70, time.Hour},
+		{time.Nanosecond, time.Duration(1<<62 + 1), 62, 1 << 62},
+		{time.Nanosecond, time.Duration(1<<62 + 1), 63, time.Duration(1<<62 + 1)},
+		{3 * time.Second, time.Duration(1<<63 - 1), 64, time.Duration(1<<63 - 1)},
+	}
+	for _, tt := range tests {
+		lockout := accessLockout{Failures: 99, Lockouts: tt.lockouts}
+		if got := lockout.recordFailure(time.Now(), 100, tt.base, tt.max); got != tt.want {
+			t.Errorf("기본 %v, 상한 %v, %d번 잠긴 뒤: 잠금 %v, want %v", tt.base, tt.max, tt.lockouts, got, tt.want)
+		}
+	}
+}
+
+func TestAccessLockoutOverflow(t *testing.T) {
+	// 잠금 횟수가 아주 많아져 시프트가 넘쳐도 상한을 돌려줘야 해요
+	lockout := accessLockout{Failures: 99, Lockouts: 70}
+	if got := lockout.recordFailure(time.Now(), 100, 30*time.Second, time.Hour); got != time.Hour {
+		t.Errorf("잠금 %v, want 상한 %v", got, time.Hour)
+	}
+}
+
+func TestAccessCommandNeedsKeyOnceRegistered(t *testing.T) {
+	savedAccess, savedStateDir, savedStdin := accessConfig, stateDirOverride, os.Stdin
+	defer func() { accessConfig, stateDirOverride, os.Stdin = savedAccess, savedStateDir, savedStdin }()
+	stateDirOverride = t.TempDir()
+	accessConfig.isPublic = false
+	accessConfig.accessKey = ""
+	accessConfig.keysFile = filepath.Join(stateDirOverride, "access_keys")
+	accessConfig.maxAttempts = 1
+	accessConfig.lockoutBase, accessConfig.lockoutMax = time.Minute, time.Hour
+
+	if !checkAccessKeyManagement() {
+		t.Fatal("키가 없을 때는 첫 키를 인증 없이 등록할 수 있어야 해요")
+	}
+
+	hash, _ := bcrypt.GenerateFromPassword([]byte("owner-password"), bcrypt.MinCost)
+	registered := "owner:" + string(hash) + "\n"
+	os.WriteFile(accessConfig.keysFile, []byte(registered), 0o600)
+
+	for _, args := range [][]string{{"add", "mallory"}, {"remove", "owner"}, {"list"}} {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			os.Remove(accessLockoutPath())
+			stdin, input, _ := os.Pipe()
+			io.WriteString(input, "wrong-password\n")
+			input.Close()
+			os.Stdin = stdin
+
+			if code := runAccessCommand(args); code != exitFailure {
+				t.Errorf("틀린 암호로 종료 코드 %d, want %d", code, exitFailure)
+			}
+			if data, _ := os.ReadFile(accessConfig.keysFile); string(data) != registered {
+				t.Errorf("인증 없이 접근 키 파일이 바뀌었어요: %q", data)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package lurker
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🗂️ 실패 분석용 아티팩트 (스크린샷, HTML, 대화상자, trace)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+const maxRecordedDialogs = 10
+
+// 💬 최근 대화상자 메시지 기록 (작업마다 따로)
+type dialogLog struct {
+	sync.Mutex
+	messages []string
+}
+
+func (s *session) recordDialogs(page playwright.Page) {
+	page.OnDialog(func(dialog playwright.Dialog) {
+		s.dialogs.Lock()
+		defer s.dialogs.Unlock()
+
+		entry := fmt.Sprintf("[%s] %s: %s", time.Now().Format("15:04:05"), dialog.Type(), dialog.Message())
+		s.dialogs.messages = append(s.dialogs.messages, entry)
+		if len(s.dialogs.messages) > maxRecordedDialogs {
+			s.dialogs.messages = s.dialogs.messages[len(s.dialogs.messages)-maxRecordedDialogs:]
+		}
+	})
+}
+
+// 🎬 trace 기록을 켜는 함수 (ARTIFACTS_TRACE=true일 때만)
+func (s *session) startTracing(context playwright.BrowserContext) {
+	if !artifactConfig.enabled || !artifactConfig.trace {
+		return
+	}
+	err := context.Tracing().Start(playwright.TracingStartOptions{
+		Screenshots: playwright.Bool(true),
+		Snapshots:   playwright.Bool(true),
+	})
+	if err != nil {
+		s.printf("⚠️ trace 기록을 시작하지 못했어요 (계속 진행): %v\n", err)
+		s.traceDisabled = true
+	}
+}
+
+// 🎬 시도 하나에 해당하는 trace 청크를 시작하는 함수
+func (s *session) beginAttemptTrace(page playwright.Page) {
+	if !artifactConfig.enabled || !artifactConfig.trace || s.traceDisabled {
+		return
+	}
+	if err := page.Context().Tracing().StartChunk(); err != nil {
+		s.printf("   ⚠️ trace 청크 시작 실패 (계속 진행): %v\n", err)
+		return
+	}
+	s.traceChunkActive = true
+}
+
+// 💾 현재 페이지 상태를 아티팩트 폴더에 저장하는 함수
+// label 예: "attempt-0003-failed", "attempt-0010-success", "preflight-failed"
+func (s *session) saveArtifacts(page playwright.Page, label string, cause error) {
+	if !artifactConfig.enabled {
+		return
+	}
+
+	if s.job.ID != "" {
+		label = s.job.ID + "-" + label
+	}
+	dir := filepath.Join(artifactConfig.dir, time.Now().Format("20060102-150405")+"-"+label)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		s.printf("   ⚠️ 아티팩트 폴더 생성 실패: %v\n", err)
+		return
+	}
+
+	screenshotOptions := playwright.PageScreenshotOptions{
+		Path:     playwright.String(filepath.Join(dir, "screenshot.png")),
+		FullPage: playwright.Bool(true),
+	}
+	if redactConfig.enabled {
+		// 입력칸에는 전화번호, 비밀번호 등이 들어 있으므로 스크린샷에서 가림
+		screenshotOptions.Mask = []playwright.Locator{page.Locator("input")}
+	}
+	if _, err := page.Screenshot(screenshotOptions); err != nil {
+		s.printf("   ⚠️ 스크린샷 저장 실패: %v\n", err)
+	}
+
+	if html, err := page.Content(); err != nil {
+		s.printf("   ⚠️ 페이지 HTML 저장 실패: %v\n", err)
+	} else if err := os.WriteFile(filepath.Join(dir, "page.html"), []byte(redact(html)), 0o644); err != nil {
+		s.printf("   ⚠️ 페이지 HTML 저장 실패: %v\n", err)
+	}
+
+	s.dialogs.Lock()
+	dialogs := append([]string(nil), s.dialogs.messages...)
+	s.dialogs.Unlock()
+
+	causeText := "(없음)"
+	if cause != nil {
+		causeText = cause.Error()
+	}
+	summary := fmt.Sprintf("시각: %s\nURL: %s\n오류: %s\n\n최근 대화상자:\n%s\n",
+		time.Now().Format(time.RFC3339), page.URL(), causeText, strings.Join(dialogs, "\n"))
+	if err := os.WriteFile(filepath.Join(dir, "summary.txt"), []byte(redact(summary)), 0o644); err != nil {
+		s.printf("   ⚠️ 요약 파일 저장 실패: %v\n", err)
+	}
+
+	if s.traceChunkActive {
+		tracePath := filepath.Join(dir, "trace.zip")
+		if err := page.Context().Tracing().StopChunk(tracePath); err != nil {
+			s.printf("   ⚠️ trace 저장 실패: %v\n", err)
+		} else if err := redactTraceArchive(tracePath); err != nil {
+			// 가리지 못한 trace는 개인정보가 그대로 남으므로 지움
+			os.Remove(tracePath)
+			s.printf("   ⚠️ trace의 개인정보를 가리지 못해 삭제했어요: %v\n", err)
+		}
+		s.traceChunkActive = false
+	}
+
+	s.printf("   🗂️ 아티팩트 저장: %s\n", dir)
+	s.pruneArtifacts()
+}
+
+// 🙈 trace 압축 파일 안의 텍스트 항목(네트워크 기록, DOM 스냅샷 등)에서 개인정보를 가리는 함수
+// 스크린샷 같은 바이너리 항목은 그대로 복사해요
+func redactTraceArchive(path string) error {
+	if !redactConfig.enabled {
+		return nil
+	}
+	reader, err := zip.OpenReader(path)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "trace-*.zip.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	writer := zip.NewWriter(tmp)
+	for _, entry := range reader.File {
+		data, err := readZipEntry(entry)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		if utf8.Valid(data) {
+			data = []byte(redact(string(data)))
+		}
+		w, err := writer.CreateHeader(&zip.FileHeader{Name: entry.Name, Method: entry.Method, Modified: entry.Modified})
+		if err == nil {
+			_, err = w.Write(data)
+		}
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	err = writer.Close()
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	reader.Close()
+	return os.Rename(tmp.Name(), path)
+}
+
+func readZipEntry(entry *zip.File) ([]byte, error) {
+	rc, err := entry.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// 🧹 보관 개수를 넘는 오래된 아티팩트 폴더를 지우는 함수
+func (s *session) pruneArtifacts() {
+	if artifactConfig.keep <= 0 {
+		return
+	}
+
+	entries, err := os.ReadDir(artifactConfig.dir)
+	if err != nil {
+		return
+	}
+
+	var dirs []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			dirs = append(dirs, entry.Name())
+		}
+	}
+	if len(dirs) <= artifactConfig.keep {
+		return
+	}
+
+	// 폴더 이름이 시각으로 시작하므로 이름순 = 시간순
+	sort.Strings(dirs)
+	for _, name := range dirs[:len(dirs)-artifactConfig.keep] {
+		if err := os.RemoveAll(filepath.Join(artifactConfig.dir, name)); err != nil {
+			s.printf("   ⚠️ 오래된 아티팩트 삭제 실패 (%s): %v\n", name, err)
+		}
+	}
+}
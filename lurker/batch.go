@@ -0,0 +1,345 @@
+package lurker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"gopkg.in/yaml.v3"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🧵 여러 작업 동시 실행 (jobs)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 📒 작업 파일 구조체 (jobs 명령, YAML)
+type jobsFile struct {
+	Jobs []jobsFileEntry `yaml:"jobs"`
+}
+
+// 📋 작업 파일의 작업 하나 (키는 같은 이름의 명령행 플래그와 같은 뜻)
+type jobsFileEntry struct {
+	ID          string   `yaml:"id"`   // 로그 파일 이름에도 쓰여요 (비우면 job-1, job-2, ...)
+	Mode        string   `yaml:"mode"` // reserve(기본) 또는 watch
+	Passenger   string   `yaml:"passenger"`
+	Route       string   `yaml:"route"`
+	Credential  string   `yaml:"credential"`
+	From        string   `yaml:"from"`
+	To          string   `yaml:"to"`
+	Date        string   `yaml:"date"`
+	DeptTime    string   `yaml:"deptTime"`
+	ArrivalTime string   `yaml:"arrivalTime"`
+	Notify      []string `yaml:"notify"`   // 이 작업의 알림 이메일 (승객 프로필의 기본 주소 대신)
+	Priority    int      `yaml:"priority"` // 같은 열차를 노리는 다른 작업보다 먼저 빈자리를 잡을 우선순위 (클수록 먼저)
+}
+
+var jobIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// 📖 작업 파일을 읽어 작업 목록으로 바꾸는 함수 (프로필과 여정을 반영하고 빠진 정보를 모두 모아 보고)
+func loadJobsFile(path string) ([]Job, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("작업 파일을 읽을 수 없어요: %w", err)
+	}
+	var file jobsFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("작업 파일 형식이 올바르지 않아요 (%s): %w", path, err)
+	}
+	if len(file.Jobs) == 0 {
+		return nil, fmt.Errorf("작업 파일에 작업이 없어요 (%s)", path)
+	}
+
+	profiles, err := loadProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []Job
+	var problems []string
+	for i, entry := range file.Jobs {
+		id := firstNonEmpty(entry.ID, fmt.Sprintf("job-%d", i+1))
+		fmt.Printf("📋 작업 %s\n", id)
+
+		job := Job{ID: id, Mode: firstNonEmpty(entry.Mode, "reserve")}
+		_, err := applyJobSpec(&job, profiles, jobSpec{
+			passenger:   entry.Passenger,
+			route:       entry.Route,
+			credential:  entry.Credential,
+			from:        entry.From,
+			to:          entry.To,
+			date:        entry.Date,
+			deptTime:    entry.DeptTime,
+			arrivalTime: entry.ArrivalTime,
+			priority:    entry.Priority,
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", id, err))
+			continue
+		}
+		if len(entry.Notify) > 0 {
+			job.NotifyEmails = entry.Notify
+		}
+
+		// 입력 마법사 없이 실행하므로 빠진 값은 미리 알려줌
+		if job.From == "" || job.To == "" {
+			problems = append(problems, id+": 출발/도착역(from/to 또는 route)이 필요해요")
+		}
+		if job.Date == "" {
+			problems = append(problems, id+": 날짜(date)가 필요해요")
+		}
+		if job.Mode == "reserve" {
+			if job.DeptTime == "" || job.ArrivalTime == "" {
+				problems = append(problems, id+": 출발/도착 시간(deptTime/arrivalTime 또는 route)이 필요해요")
+			}
+			if job.Credential == "" {
+				problems = append(problems, id+": 보관함 자격 증명(credential 또는 credential이 있는 passenger)이 필요해요")
+			}
+		}
+		jobs = append(jobs, job)
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("작업 파일에 문제가 %d건 있어요:\n   - %s", len(problems), strings.Join(problems, "\n   - "))
+	}
+	return jobs, nil
+}
+
+// 📁 작업별 로그 폴더 (설정 jobs.logDir 또는 상태 폴더/jobs)
+func jobsLogDir() string {
+	if jobsConfig.logDir != "" {
+		return jobsConfig.logDir
+	}
+	return filepath.Join(stateDir(), "jobs")
+}
+
+// 🧵 작업 여러 개를 브라우저 하나로 함께 실행하는 함수 (작업마다 브라우저 컨텍스트, 상태, 로그 파일을 따로 가짐)
+// 작업 정보에 문제가 있거나 브라우저를 띄우지 못하면 아무 작업도 시작하지 않고 오류를 돌려줘요
+func runBatch(ctx context.Context, jobs []Job, opts BatchOptions) ([]BatchResult, error) {
+	if opts.MaxConcurrent <= 0 {
+		opts.MaxConcurrent = jobsConfig.maxConcurrent
+	}
+	if opts.LogDir == "" {
+		opts.LogDir = jobsLogDir()
+	}
+	if opts.Output == nil {
+		opts.Output = os.Stdout
+	}
+	opts.Output = newRedactingWriter(opts.Output)
+
+	// 🔍 시작하기 전에 모든 작업을 점검 (보관함 암호는 한 번만 물어봄)
+	var credentials map[string]vaultCredential
+	var problems []string
+	seen := map[string]bool{}
+	prepared := make([]Job, len(jobs))
+	for i, job := range jobs {
+		job.ID = firstNonEmpty(job.ID, fmt.Sprintf("job-%d", i+1))
+		if !jobIDPattern.MatchString(job.ID) {
+			problems = append(problems, fmt.Sprintf("작업 이름은 영문, 숫자, '.', '_', '-'만 쓸 수 있어요: %s", job.ID))
+		}
+		if seen[job.ID] {
+			problems = append(problems, fmt.Sprintf("작업 이름이 겹쳐요: %s", job.ID))
+		}
+		seen[job.ID] = true
+
+		if needsVaultCredential(job) {
+			if credentials == nil {
+				if !vaultExists() {
+					return nil, fmt.Errorf("보관함이 없어요 (%s). 먼저 'vault add %s'로 저장해주세요", vaultPath(), job.Credential)
+				}
+				passphrase, err := resolveVaultPassphrase(opts.VaultPassphrase)
+				if err != nil {
+					return nil, err
+				}
+				if credentials, err = loadVault(passphrase); err != nil {
+					return nil, err
+				}
+			}
+			if err := applyStoredCredential(&job, job.Credential, credentials, opts.Output); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %v", job.ID, err))
+			}
+		}
+		for _, problem := range normalizeJob(&job) {
+			problems = append(problems, fmt.Sprintf("%s: %s", job.ID, problem))
+		}
+		prepared[i] = job
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("작업 정보가 올바르지 않아요: %s", strings.Join(problems, "; "))
+	}
+	if err := os.MkdirAll(opts.LogDir, 0o700); err != nil {
+		return nil, fmt.Errorf("작업 로그 폴더를 만들 수 없어요: %w", err)
+	}
+
+	pw, browser, err := launchBrowser(browserConfig.headless)
+	if err != nil {
+		return nil, err
+	}
+	defer pw.Stop()
+	defer browser.Close()
+
+	// 🚦 동시에 실행할 수 있는 작업 수만큼만 자리를 두고, 작업 파일 순서대로 자리가 나면 시작
+	slots := make(chan struct{}, opts.MaxConcurrent)
+	results := make([]BatchResult, len(prepared))
+	var wg sync.WaitGroup
+	for i, job := range prepared {
+		results[i] = BatchResult{ID: job.ID, LogPath: filepath.Join(opts.LogDir, job.ID+".log")}
+		if ctx.Err() == nil {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+			}
+		}
+		if ctx.Err() != nil {
+			results[i].Err = fmt.Errorf("시작하기 전에 중단됐어요: %w", context.Cause(ctx))
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *BatchResult, job Job) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			result.Result, result.Err = runBatchJob(ctx, browser, job, opts, result.LogPath)
+		}(&results[i], job)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// 🚄 함께 실행하는 작업 하나를 실행하는 함수 (진행 메시지는 콘솔과 작업 로그 파일에 함께 씀)
+func runBatchJob(ctx context.Context, browser playwright.Browser, job Job, opts BatchOptions, logPath string) (Result, error) {
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return Result{}, fmt.Errorf("작업 로그 파일을 열 수 없어요: %w", err)
+	}
+	defer logFile.Close()
+
+	out := &jobLogWriter{id: job.ID, console: opts.Output, file: newRedactingWriter(logFile)}
+	defer out.flush()
+	fmt.Fprintf(out.file, "===== %s 작업 %s 시작\n", time.Now().Format(time.RFC3339), job.ID)
+
+	s := newSession(ctx, job, out)
+	s.dryRun = opts.DryRun
+	s.shared = true
+	return s.runJob(browser)
+}
+
+// 여러 작업이 같은 콘솔에 쓸 때 줄이 섞이지 않게 한 줄씩 쓰도록
+var consoleLines sync.Mutex
+
+// 📝 작업 하나의 진행 메시지를 줄 단위로 콘솔(앞에 작업 이름)과 작업 로그 파일(앞에 시각)에 쓰는 Writer
+// 콘솔과 로그 파일은 모두 redactingWriter라서 한 줄씩 쓸 때 개인정보를 가려요
+// 캐리지 리턴(\r)으로 같은 줄을 고쳐 쓰는 로딩 애니메이션은 마지막 내용만 남겨요
+type jobLogWriter struct {
+	sync.Mutex
+	id      string
+	console io.Writer
+	file    io.Writer
+	line    []byte
+}
+
+func (w *jobLogWriter) Write(p []byte) (int, error) {
+	w.Lock()
+	defer w.Unlock()
+	for _, b := range p {
+		switch b {
+		case '\n':
+			w.emit()
+		case '\r':
+			w.line = w.line[:0]
+		default:
+			w.line = append(w.line, b)
+		}
+	}
+	return len(p), nil
+}
+
+func (w *jobLogWriter) emit() {
+	line := string(w.line)
+	w.line = w.line[:0]
+
+	consoleLines.Lock()
+	fmt.Fprintf(w.console, "[%s] %s\n", w.id, line)
+	consoleLines.Unlock()
+	fmt.Fprintf(w.file, "%s %s\n", time.Now().Format("15:04:05"), line)
+}
+
+// 줄바꿈 없이 남은 마지막 내용을 쓰는 함수 (작업이 끝날 때)
+func (w *jobLogWriter) flush() {
+	w.Lock()
+	defer w.Unlock()
+	if len(w.line) > 0 {
+		w.emit()
+	}
+}
+
+// 🧵 jobs 명령 (작업 파일의 작업들을 함께 실행하고 결과를 요약)
+func runJobsCommand(args []string, concurrency int) int {
+	if len(args) != 1 {
+		fmt.Println("❌ 작업 파일을 하나 지정해주세요 (예: srt-lurker jobs jobs.yaml)")
+		return exitUsage
+	}
+	if concurrency < 0 {
+		fmt.Println("❌ --concurrency는 1 이상이어야 해요")
+		return exitUsage
+	}
+	jobs, err := loadJobsFile(args[0])
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitUsage
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+
+	limit := concurrency
+	if limit == 0 {
+		limit = jobsConfig.maxConcurrent
+	}
+	fmt.Printf("\n🧵 작업 %d개를 최대 %d개씩 함께 실행해요 (작업별 로그: %s)\n", len(jobs), limit, jobsLogDir())
+	results, err := runBatch(ctx, jobs, BatchOptions{MaxConcurrent: concurrency, DryRun: runOptions.dryRun, VaultPassphrase: promptVaultPassphrase})
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitFailure
+	}
+
+	printHeader("🧵 작업 결과")
+	outcomeText := map[string]string{
+		"reserved":         "✅ 예약 성공",
+		"dry-run":          "🧪 드라이런",
+		"failed":           "❌ 모든 시도 실패",
+		"stopped":          "⛔ 원격 중지",
+		"interrupted":      "⛔ 사용자 중단",
+		"preflight-failed": "🩺 사전 점검 실패",
+	}
+	code := exitOK
+	for _, result := range results {
+		text := firstNonEmpty(outcomeText[result.Result.Outcome], result.Result.Outcome, "❌ 시작 못 함")
+		fmt.Printf("   [%s] %s (시도 %d회)", result.ID, text, result.Result.Attempts)
+		if result.Result.TrainNumber != "" {
+			fmt.Printf(", 열차 %s", result.Result.TrainNumber)
+		}
+		fmt.Println()
+		if result.Err != nil && result.Result.Outcome != "interrupted" {
+			fmt.Printf("      오류: %s\n", redact(result.Err.Error()))
+		}
+		fmt.Printf("      로그: %s\n", result.LogPath)
+
+		switch {
+		case ctx.Err() != nil:
+			code = exitInterrupted
+		case result.Err != nil:
+			code = exitFailure
+		}
+	}
+	return code
+}
@@ -0,0 +1,87 @@
+package lurker
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestJobLogWriter(t *testing.T) {
+	tests := []struct {
+		name    string
+		writes  []string
+		console string
+		file    []string // 시각을 뺀 로그 파일 줄
+	}{
+		{
+			name:    "한 줄씩",
+			writes:  []string{"▶ 시작\n", "✓ 완료\n"},
+			console: "[alice] ▶ 시작\n[alice] ✓ 완료\n",
+			file:    []string{"▶ 시작", "✓ 완료"},
+		},
+		{
+			name:    "여러 번 나눠 쓴 줄과 한 번에 쓴 여러 줄",
+			writes:  []string{"시도 ", "1 실패", "\n둘째 줄\n셋째"},
+			console: "[alice] 시도 1 실패\n[alice] 둘째 줄\n[alice] 셋째\n",
+			file:    []string{"시도 1 실패", "둘째 줄", "셋째"},
+		},
+		{
+			name:    "캐리지 리턴으로 고쳐 쓴 줄은 마지막 내용만",
+			writes:  []string{"\r   ⏰ 00:03", "\r   ⏰ 00:02", "\r   ⏰ 00:01", "\n"},
+			console: "[alice]    ⏰ 00:01\n",
+			file:    []string{"   ⏰ 00:01"},
+		},
+		{
+			name:    "빈 줄",
+			writes:  []string{"\n"},
+			console: "[alice] \n",
+			file:    []string{""},
+		},
+	}
+	timestamp := regexp.MustCompile(`^\d{2}:\d{2}:\d{2} `)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var console, file bytes.Buffer
+			w := &jobLogWriter{id: "alice", console: &console, file: &file}
+			for _, chunk := range tt.writes {
+				if n, err := w.Write([]byte(chunk)); err != nil || n != len(chunk) {
+					t.Fatalf("Write(%q) = %d, %v", chunk, n, err)
+				}
+			}
+			w.flush()
+
+			if console.String() != tt.console {
+				t.Errorf("콘솔\n got %q\nwant %q", console.String(), tt.console)
+			}
+			lines := strings.Split(strings.TrimSuffix(file.String(), "\n"), "\n")
+			if len(lines) != len(tt.file) {
+				t.Fatalf("로그 파일 %d줄, want %d줄: %q", len(lines), len(tt.file), file.String())
+			}
+			for i, line := range lines {
+				if !timestamp.MatchString(line) {
+					t.Errorf("로그 줄 앞에 시각이 없어요: %q", line)
+					continue
+				}
+				if got := timestamp.ReplaceAllString(line, ""); got != tt.file[i] {
+					t.Errorf("로그 %d번째 줄 = %q, want %q", i+1, got, tt.file[i])
+				}
+			}
+		})
+	}
+}
+
+func TestJobLogWriterRedacts(t *testing.T) {
+	redactConfig.enabled = true
+	var console, file bytes.Buffer
+	w := &jobLogWriter{id: "bob", console: newRedactingWriter(&console), file: newRedactingWriter(&file)}
+	s := newSession(t.Context(), Job{ID: "bob"}, w)
+	s.printf("예약자 전화번호: %s\n", "010-2222-3333")
+	w.flush()
+
+	for name, out := range map[string]string{"콘솔": console.String(), "로그 파일": file.String()} {
+		if strings.Contains(out, "2222") || !strings.Contains(out, "010-****-3333") {
+			t.Errorf("%s에서 전화번호를 가리지 않았어요: %q", name, out)
+		}
+	}
+}
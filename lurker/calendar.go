@@ -0,0 +1,270 @@
+package lurker
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 📅 예약 확인 정보와 캘린더(.ics)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// SRT 시간은 모두 한국 표준시 (서머타임 없음, tzdata가 없는 환경에서도 동작하도록 고정 오프셋 사용)
+var kstLocation = time.FixedZone("KST", 9*60*60)
+
+// 🎫 예약 완료 화면에서 읽은 확인 정보 (못 읽은 항목은 빈 값)
+type reservationConfirmation struct {
+	reservationNumber string
+	seat              string // 예: "5호차 12A"
+	trainNumber       string
+	paymentDeadline   time.Time
+}
+
+var (
+	reservationNumberPattern = regexp.MustCompile(`예약\s*번호\s*[:：]?\s*([0-9][0-9-]{5,})`)
+	seatPattern              = regexp.MustCompile(`(\d{1,2})\s*호차\s*(\d{1,2}[A-Z])`)
+	confirmTrainPattern      = regexp.MustCompile(`(?:열차\s*번호\s*[:：]?\s*|SRT\s*)(\d{3,4})`)
+	paymentDeadlinePattern   = regexp.MustCompile(`결제\s*기[한간]\s*[:：]?\s*(\d{4})\s*[.\-/년]\s*(\d{1,2})\s*[.\-/월]\s*(\d{1,2})\s*일?\s*(?:\([^)]*\))?\s*(\d{1,2})\s*[:시]\s*(\d{2})`)
+	clockPattern             = regexp.MustCompile(`(\d{1,2}):(\d{2})`)
+)
+
+// 🔍 예약 완료 화면의 텍스트에서 예약번호, 좌석, 열차번호, 결제 기한을 읽는 함수
+func parseReservationConfirmation(text string) reservationConfirmation {
+	var confirmation reservationConfirmation
+	if match := reservationNumberPattern.FindStringSubmatch(text); match != nil {
+		confirmation.reservationNumber = match[1]
+	}
+	var seats []string
+	for _, match := range seatPattern.FindAllStringSubmatch(text, -1) {
+		seats = append(seats, match[1]+"호차 "+match[2])
+	}
+	confirmation.seat = strings.Join(seats, ", ")
+	if match := confirmTrainPattern.FindStringSubmatch(text); match != nil {
+		confirmation.trainNumber = match[1]
+	}
+	if match := paymentDeadlinePattern.FindStringSubmatch(text); match != nil {
+		var parts [5]int
+		for i := range parts {
+			parts[i], _ = strconv.Atoi(match[i+1])
+		}
+		confirmation.paymentDeadline = time.Date(parts[0], time.Month(parts[1]), parts[2], parts[3], parts[4], 0, 0, kstLocation)
+	}
+	return confirmation
+}
+
+// 🎫 예약 완료 화면에서 확인 정보를 읽는 함수 (못 읽은 항목은 비워두고 열차번호는 조회 결과로 채움)
+func (s *session) readReservationConfirmation(page playwright.Page) reservationConfirmation {
+	selector := sel("reservationConfirmation")
+	if selector == "" {
+		selector = "body"
+	}
+
+	var confirmation reservationConfirmation
+	if text, err := page.Locator(selector).First().InnerText(); err == nil {
+		confirmation = parseReservationConfirmation(text)
+	} else {
+		s.printf("   ⚠️ 예약 확인 정보를 읽지 못했어요: %v\n", err)
+	}
+	if confirmation.trainNumber == "" {
+		confirmation.trainNumber = s.selectedTrain.trainNumber
+	}
+	return confirmation
+}
+
+func (s *session) printReservationConfirmation(confirmation reservationConfirmation) {
+	valueOrUnknown := func(value string) string {
+		if value == "" {
+			return "(확인 안 됨)"
+		}
+		return value
+	}
+	s.printf("   🎫 예약번호: %s\n", valueOrUnknown(confirmation.reservationNumber))
+	s.printf("   💺 좌석: %s\n", valueOrUnknown(confirmation.seat))
+	if !confirmation.paymentDeadline.IsZero() {
+		s.printf("   💳 결제 기한: %s\n", confirmation.paymentDeadline.Format("2006-01-02 15:04"))
+	}
+}
+
+// 출발/도착 시각 (조회 결과 텍스트에 시각이 있으면 그것을, 없으면 입력한 시간을 사용)
+func tripTimes(event notificationEvent) (time.Time, time.Time, error) {
+	date, err := time.ParseInLocation("20060102", event.trip.date, kstLocation)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("출발 날짜를 해석할 수 없어요 (%s)", event.trip.date)
+	}
+	at := func(text, fallback string) time.Time {
+		match := clockPattern.FindStringSubmatch(text)
+		if match == nil {
+			match = clockPattern.FindStringSubmatch(fallback)
+		}
+		if match == nil {
+			return date
+		}
+		hour, _ := strconv.Atoi(match[1])
+		minute, _ := strconv.Atoi(match[2])
+		return date.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+
+	departure := at(event.train.deptText, event.trip.deptTime)
+	arrival := at(event.train.arrivalText, event.trip.arrivalTime)
+	// 자정을 넘겨 도착하는 열차
+	if !arrival.After(departure) {
+		arrival = arrival.Add(24 * time.Hour)
+	}
+	return departure, arrival, nil
+}
+
+func tripCalendarFileName(event notificationEvent) string {
+	name := "srt-" + event.trip.date
+	if trainNumber := firstNonEmpty(event.reservation.trainNumber, event.train.trainNumber); trainNumber != "" {
+		name += "-" + trainNumber
+	}
+	if event.reservation.reservationNumber != "" {
+		name += "-" + event.reservation.reservationNumber
+	}
+	return name + ".ics"
+}
+
+// 📅 예약한 열차와 결제 기한 알림을 담은 iCalendar 파일을 만드는 함수
+func buildTripCalendar(event notificationEvent) []byte {
+	trainNumber := firstNonEmpty(event.reservation.trainNumber, event.train.trainNumber)
+	uidBase := event.reservation.reservationNumber
+	if uidBase == "" {
+		uidBase = event.trip.date + "-" + trainNumber + "-" + strconv.FormatInt(event.time.Unix(), 10)
+	}
+	stamp := event.time.UTC().Format("20060102T150405Z")
+	localTime := func(t time.Time) string {
+		return t.In(kstLocation).Format("20060102T150405")
+	}
+
+	var lines []string
+	// 파일로 저장하거나 메일에 첨부하므로 줄마다 개인정보를 가림 (접기 전에 가려야 값이 잘리지 않음)
+	add := func(line string) { lines = append(lines, redact(line)) }
+
+	add("BEGIN:VCALENDAR")
+	add("VERSION:2.0")
+	add("PRODID:-//SRT Lurker//KO")
+	add("CALSCALE:GREGORIAN")
+	add("METHOD:PUBLISH")
+	add("BEGIN:VTIMEZONE")
+	add("TZID:Asia/Seoul")
+	add("BEGIN:STANDARD")
+	add("DTSTART:19700101T000000")
+	add("TZOFFSETFROM:+0900")
+	add("TZOFFSETTO:+0900")
+	add("TZNAME:KST")
+	add("END:STANDARD")
+	add("END:VTIMEZONE")
+
+	description := []string{"열차번호: SRT " + trainNumber}
+	if event.reservation.seat != "" {
+		description = append(description, "좌석: "+event.reservation.seat)
+	}
+	if event.reservation.reservationNumber != "" {
+		description = append(description, "예약번호: "+event.reservation.reservationNumber)
+	}
+	if !event.reservation.paymentDeadline.IsZero() {
+		description = append(description, "결제 기한: "+event.reservation.paymentDeadline.In(kstLocation).Format("2006-01-02 15:04"))
+	}
+	if event.trip.reserverName != "" {
+		description = append(description, "예약자: "+event.trip.reserverName)
+	}
+
+	if departure, arrival, err := tripTimes(event); err == nil {
+		add("BEGIN:VEVENT")
+		add("UID:" + uidBase + "-trip@srt-lurker")
+		add("DTSTAMP:" + stamp)
+		add("DTSTART;TZID=Asia/Seoul:" + localTime(departure))
+		add("DTEND;TZID=Asia/Seoul:" + localTime(arrival))
+		add("SUMMARY:" + icsEscape(fmt.Sprintf("🚄 SRT %s %s → %s", trainNumber, event.trip.deptStation, event.trip.arrivalStation)))
+		add("LOCATION:" + icsEscape(event.trip.deptStation+"역"))
+		add("DESCRIPTION:" + icsEscape(strings.Join(description, "\n")))
+		add("BEGIN:VALARM")
+		add("ACTION:DISPLAY")
+		add("DESCRIPTION:" + icsEscape("SRT 출발 1시간 전이에요"))
+		add("TRIGGER:-PT1H")
+		add("END:VALARM")
+		add("END:VEVENT")
+	}
+
+	if deadline := event.reservation.paymentDeadline; !deadline.IsZero() {
+		add("BEGIN:VEVENT")
+		add("UID:" + uidBase + "-payment@srt-lurker")
+		add("DTSTAMP:" + stamp)
+		add("DTSTART;TZID=Asia/Seoul:" + localTime(deadline))
+		add("DTEND;TZID=Asia/Seoul:" + localTime(deadline))
+		add("SUMMARY:" + icsEscape("💳 SRT "+trainNumber+" 결제 기한"))
+		add("DESCRIPTION:" + icsEscape("기한까지 결제하지 않으면 예약이 자동 취소돼요\n"+strings.Join(description, "\n")))
+		add("BEGIN:VALARM")
+		add("ACTION:DISPLAY")
+		add("DESCRIPTION:" + icsEscape("SRT 결제 기한이 곧 끝나요"))
+		add("TRIGGER:-PT5M")
+		add("END:VALARM")
+		add("END:VEVENT")
+	}
+	add("END:VCALENDAR")
+
+	var buf bytes.Buffer
+	for _, line := range lines {
+		buf.WriteString(foldICSLine(line))
+	}
+	return buf.Bytes()
+}
+
+// iCalendar TEXT 값 이스케이프 (RFC 5545 3.3.11)
+func icsEscape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(value)
+}
+
+// 75바이트마다 줄을 접는 함수 (UTF-8 글자 중간에서 자르지 않음, RFC 5545 3.1)
+func foldICSLine(line string) string {
+	var buf strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > 75 {
+			buf.WriteString("\r\n ")
+			width = 1
+		}
+		buf.WriteRune(r)
+		width += size
+	}
+	buf.WriteString("\r\n")
+	return buf.String()
+}
+
+// 💾 예약 캘린더 파일을 CALENDAR_DIR에 저장하는 함수
+func (s *session) saveTripCalendar(event notificationEvent) {
+	if !calendarConfig.enabled {
+		return
+	}
+	if err := os.MkdirAll(calendarConfig.dir, 0o755); err != nil {
+		s.printf("   ⚠️ 캘린더 폴더 생성 실패: %v\n", err)
+		return
+	}
+	if _, _, err := tripTimes(event); err != nil {
+		s.printf("   ⚠️ 캘린더에 열차 일정을 넣지 못했어요: %v\n", err)
+	}
+	path := filepath.Join(calendarConfig.dir, tripCalendarFileName(event))
+	if err := os.WriteFile(path, buildTripCalendar(event), 0o644); err != nil {
+		s.printf("   ⚠️ 캘린더 파일 저장 실패: %v\n", err)
+		return
+	}
+	s.printf("   📅 캘린더 파일을 저장했어요: %s\n", path)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
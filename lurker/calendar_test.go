@@ -0,0 +1,136 @@
+package lurker
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestParseReservationConfirmation(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want reservationConfirmation
+	}{
+		{
+			name: "모든 항목",
+			text: "예약번호: 1234-567890\nSRT 305 수서 → 부산\n5호차 12A\n결제기한 2026.03.01(일) 08:20",
+			want: reservationConfirmation{
+				reservationNumber: "1234-567890",
+				seat:              "5호차 12A",
+				trainNumber:       "305",
+				paymentDeadline:   time.Date(2026, 3, 1, 8, 20, 0, 0, kstLocation),
+			},
+		},
+		{
+			name: "좌석 여러 개와 한글 날짜",
+			text: "예약 번호 98765432\n열차번호 : 0301\n3호차 7A 3호차 7B\n결제 기간: 2026년 3월 1일 21시 05",
+			want: reservationConfirmation{
+				reservationNumber: "98765432",
+				seat:              "3호차 7A, 3호차 7B",
+				trainNumber:       "0301",
+				paymentDeadline:   time.Date(2026, 3, 1, 21, 5, 0, 0, kstLocation),
+			},
+		},
+		{
+			name: "읽을 수 있는 항목이 없음",
+			text: "예약이 완료되었습니다",
+			want: reservationConfirmation{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseReservationConfirmation(tt.text)
+			if got.reservationNumber != tt.want.reservationNumber || got.seat != tt.want.seat ||
+				got.trainNumber != tt.want.trainNumber || !got.paymentDeadline.Equal(tt.want.paymentDeadline) {
+				t.Errorf("got %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFoldICSLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{"짧은 줄", "SUMMARY:SRT 305"},
+		{"정확히 75바이트", "DESCRIPTION:" + strings.Repeat("a", 63)},
+		{"긴 ASCII 줄", "DESCRIPTION:" + strings.Repeat("x", 200)},
+		{"긴 한글 줄 (글자 중간에서 자르지 않음)", "DESCRIPTION:" + strings.Repeat("수서역", 40)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			folded := foldICSLine(tt.line)
+			if !strings.HasSuffix(folded, "\r\n") {
+				t.Fatalf("CRLF로 끝나지 않아요: %q", folded)
+			}
+			parts := strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n")
+			for i, part := range parts {
+				if len(part) > 75 {
+					t.Errorf("%d번째 줄이 75바이트를 넘어요 (%d바이트)", i, len(part))
+				}
+				if !utf8.ValidString(part) {
+					t.Errorf("%d번째 줄에서 UTF-8 글자가 잘렸어요: %q", i, part)
+				}
+				if i > 0 && !strings.HasPrefix(part, " ") {
+					t.Errorf("이어지는 줄은 공백으로 시작해야 해요: %q", part)
+				}
+				if i > 0 {
+					parts[i] = part[1:]
+				}
+			}
+			if unfolded := strings.Join(parts, ""); unfolded != tt.line {
+				t.Errorf("펼친 줄이 원래와 달라요\n got %q\nwant %q", unfolded, tt.line)
+			}
+		})
+	}
+}
+
+func TestBuildTripCalendar(t *testing.T) {
+	redactConfig.enabled = true
+	event := notificationEvent{
+		eventType: eventReserved,
+		time:      time.Date(2026, 2, 20, 9, 0, 0, 0, time.UTC),
+		trip: tripSummary{
+			deptStation: "수서", arrivalStation: "부산", date: "20260301",
+			deptTime: "08:00", arrivalTime: "10:30", reserverName: "홍길동 (010-1234-5678)",
+		},
+		train: trainAvailability{trainNumber: "305", deptText: "수서\n08:00", arrivalText: "부산\n10:40"},
+		reservation: reservationConfirmation{
+			reservationNumber: "1234567",
+			seat:              "5호차 12A",
+			paymentDeadline:   time.Date(2026, 2, 20, 18, 20, 0, 0, kstLocation),
+		},
+	}
+
+	calendar := string(buildTripCalendar(event))
+	unfolded := strings.ReplaceAll(calendar, "\r\n ", "")
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:1234567-trip@srt-lurker\r\n",
+		"DTSTART;TZID=Asia/Seoul:20260301T080000\r\n",
+		"DTEND;TZID=Asia/Seoul:20260301T104000\r\n",
+		"SUMMARY:🚄 SRT 305 수서 → 부산\r\n",
+		"UID:1234567-payment@srt-lurker\r\n",
+		"DTSTART;TZID=Asia/Seoul:20260220T182000\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(unfolded, want) {
+			t.Errorf("캘린더에 %q가 없어요\n%s", want, unfolded)
+		}
+	}
+	if strings.Contains(unfolded, "1234-5678") {
+		t.Error("캘린더에 전화번호가 그대로 남았어요")
+	}
+	if got := strings.Count(unfolded, "BEGIN:VEVENT"); got != 2 {
+		t.Errorf("일정 %d개, want 2개 (열차, 결제 기한)", got)
+	}
+
+	// 날짜를 해석할 수 없으면 결제 기한 일정만 남음
+	event.trip.date = "언젠가"
+	if got := strings.Count(string(buildTripCalendar(event)), "BEGIN:VEVENT"); got != 1 {
+		t.Errorf("날짜가 잘못된 경우 일정 %d개, want 1개", got)
+	}
+}
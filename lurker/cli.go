@@ -0,0 +1,396 @@
+package lurker
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🧭 명령행 인터페이스 (하위 명령, 플래그, 종료 코드)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🎛️ 명령행 실행 옵션
+var runOptions = struct {
+	dryRun     bool   // 최종 예약 확정 직전에 멈추고 결과만 보고
+	credential string // 보관함에 저장된 자격 증명 이름 (비어 있으면 직접 입력)
+	configPath string // YAML 설정 파일 경로 (비어 있으면 기본 위치에서 찾음)
+	passenger  string // 사용할 승객 프로필 이름 (비어 있으면 목록에서 선택)
+	route      string // 사용할 경로 프리셋 이름 (비어 있으면 목록에서 선택)
+	mode       string // "reserve", "watch" (비어 있으면 입력 마법사에서 선택)
+	selectors  string // 셀렉터 프로필 파일 경로 (비어 있으면 내장 프로필)
+
+	// 명령행으로 정한 여정 (비어 있으면 프로필이나 입력 마법사 사용)
+	from        string
+	to          string
+	date        string // YYYYMMDD 또는 YYYY-MM-DD
+	deptTime    string // HHMM 또는 HH:MM
+	arrivalTime string
+
+	priority int // 같은 열차를 노리는 다른 작업과의 우선순위 (클수록 먼저)
+}{
+	dryRun:     false,
+	credential: "",
+	configPath: "",
+	passenger:  "",
+	route:      "",
+	mode:       "",
+	selectors:  "",
+}
+
+// 종료 코드 (모든 명령 공통)
+const (
+	exitOK          = 0   // 성공 (예약 완료, 드라이런 보고, 감시 중지, 명령 완료)
+	exitFailure     = 1   // 실패 (모든 시도 실패, 사전 점검 실패, 설정/인증 오류)
+	exitUsage       = 2   // 잘못된 명령, 플래그, 인자
+	exitInterrupted = 130 // Ctrl+C 등으로 중단
+)
+
+// ⛔ Ctrl+C나 SIGTERM을 받으면 취소되는 컨텍스트 (작업이 중단 알림과 실행 기록을 남기고 정리할 수 있게)
+// 정리하는 동안 한 번 더 누르면 바로 종료해요
+func signalContext() (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig, ok := <-signals
+		if !ok {
+			return
+		}
+		cancel(fmt.Errorf("중단 신호를 받았어요: %v", sig))
+		if _, ok := <-signals; ok {
+			os.Exit(exitInterrupted)
+		}
+	}()
+	return ctx, func() {
+		signal.Stop(signals)
+		close(signals)
+		cancel(nil)
+	}
+}
+
+// 🧭 하위 명령 정의
+type cliCommand struct {
+	name     string
+	args     string // 사용법에 표시할 위치 인자
+	summary  string
+	noConfig bool // 설정을 읽지 않고 바로 실행 (help, stations)
+	strict   bool // 설정 점검(config validate)을 통과해야 실행
+	browser  bool // 브라우저를 쓰는 명령 (셀렉터 프로필 필요)
+	access   bool // 접근 제어를 통과해야 실행
+	flags    func(fs *flag.FlagSet)
+	run      func(fs *flag.FlagSet) int
+}
+
+// 🧭 지원하는 하위 명령 목록 (도움말에 이 순서로 표시)
+func cliCommands() []cliCommand {
+	var historyLimit int
+	var listenAddr string
+	var serveWatch bool
+	var jobsConcurrency int
+
+	return []cliCommand{
+		{
+			name: "run", summary: "빈자리가 나면 바로 예약해요 (정하지 않은 항목은 입력 마법사로 물어봐요)",
+			strict: true, browser: true, access: true,
+			flags: func(fs *flag.FlagSet) {
+				addJobFlags(fs)
+				fs.BoolVar(&runOptions.dryRun, "dry-run", false, "최종 예약 확정 직전까지만 진행하고 예약될 내용을 보고 (예약하기 버튼은 실제로 누름)")
+			},
+			run: func(fs *flag.FlagSet) int {
+				runOptions.mode = "reserve"
+				return runInteractiveJob()
+			},
+		},
+		{
+			name: "watch", summary: "예약하지 않고 빈자리가 생기면 알려줘요",
+			strict: true, browser: true, access: true,
+			flags: addJobFlags,
+			run: func(fs *flag.FlagSet) int {
+				runOptions.mode = "watch"
+				return runInteractiveJob()
+			},
+		},
+		{
+			name: "search", summary: "열차와 좌석 상태를 한 번 조회해요 (예약하지 않음)",
+			strict: true, browser: true, access: true,
+			flags: addTripFlags,
+			run:   func(fs *flag.FlagSet) int { return runSearchCommand() },
+		},
+		{
+			name: "stations", summary: "SRT 역 목록을 보여줘요",
+			noConfig: true,
+			run: func(fs *flag.FlagSet) int {
+				for _, station := range srtStations {
+					fmt.Println(station)
+				}
+				return exitOK
+			},
+		},
+		{
+			name: "history", summary: "최근 실행 기록을 보여줘요",
+			strict: true, access: true,
+			flags: func(fs *flag.FlagSet) {
+				fs.IntVar(&historyLimit, "limit", 20, "보여줄 기록 수 (0: 전부)")
+			},
+			run: func(fs *flag.FlagSet) int { return runHistoryCommand(historyLimit) },
+		},
+		{
+			name: "config", args: "validate | defaults | path", summary: "설정을 점검하거나 기본 설정 파일을 출력해요",
+			run: func(fs *flag.FlagSet) int { return runConfigCommand(fs.Args()) },
+		},
+		{
+			name: "setup", summary: "질문에 답해 사용자 설정 파일(config.yaml)을 만들어요",
+			run: func(fs *flag.FlagSet) int { return runSetup() },
+		},
+		{
+			name: "vault", args: "add <이름> | list | remove <이름>", summary: "암호로 잠근 자격 증명 보관함을 관리해요",
+			strict: true, access: true,
+			run: func(fs *flag.FlagSet) int { return runVaultCommand(fs.Args()) },
+		},
+		{
+			name: "profile", args: "list | add-passenger <이름> | add-route <이름> | remove <passenger|route> <이름>",
+			summary: "승객 프로필과 경로 프리셋을 관리해요",
+			strict:  true, access: true,
+			run: func(fs *flag.FlagSet) int { return runProfileCommand(fs.Args()) },
+		},
+		{
+			name: "access", args: "add <이름> [만료일] | list | remove <이름> | hash", summary: "비공개 모드의 접근 키를 관리해요",
+			strict: true,
+			run:    func(fs *flag.FlagSet) int { return runAccessCommand(fs.Args()) },
+		},
+		{
+			name: "doctor", summary: "SRT 사이트 구조가 셀렉터 프로필과 맞는지 점검해요",
+			strict: true, browser: true, access: true,
+			run: func(fs *flag.FlagSet) int { return runDoctor() },
+		},
+		{
+			name: "serve", summary: "입력을 묻지 않고 작업을 실행하며 HTTP 제어 API를 열어요",
+			strict: true, browser: true, access: true,
+			flags: func(fs *flag.FlagSet) {
+				addJobFlags(fs)
+				fs.BoolVar(&runOptions.dryRun, "dry-run", false, "최종 예약 확정 직전까지만 진행하고 예약될 내용을 보고 (예약하기 버튼은 실제로 누름)")
+				fs.BoolVar(&serveWatch, "watch", false, "예약하지 않고 빈자리 감시만 실행")
+				fs.StringVar(&listenAddr, "listen", "127.0.0.1:8787", "제어 API 주소 (다른 컴퓨터에서 접속하려면 SERVE_TOKEN 필요)")
+			},
+			run: func(fs *flag.FlagSet) int {
+				if serveWatch {
+					runOptions.mode = "watch"
+				}
+				if !flagWasSet(fs, "headless") {
+					browserConfig.headless = true
+				}
+				return runServeCommand(listenAddr)
+			},
+		},
+		{
+			name: "jobs", args: "<작업 파일>", summary: "작업 파일의 예약/감시 작업 여러 개를 브라우저 하나로 함께 실행해요",
+			strict: true, browser: true, access: true,
+			flags: func(fs *flag.FlagSet) {
+				fs.IntVar(&jobsConcurrency, "concurrency", 0, "동시에 실행할 작업 수 (기본: 설정 jobs.maxConcurrent, 환경변수 MAX_CONCURRENT_JOBS)")
+				fs.BoolVar(&runOptions.dryRun, "dry-run", false, "모든 작업을 최종 예약 확정 직전까지만 진행하고 예약될 내용을 보고 (예약하기 버튼은 실제로 누름)")
+				fs.Bool("headless", false, "브라우저 창 없이 실행 (지정하지 않으면 창 없이 실행)")
+			},
+			run: func(fs *flag.FlagSet) int {
+				if !flagWasSet(fs, "headless") {
+					browserConfig.headless = true
+				}
+				return runJobsCommand(fs.Args(), jobsConcurrency)
+			},
+		},
+		{
+			name: "help", args: "[명령]", summary: "도움말을 보여줘요",
+			noConfig: true,
+		},
+	}
+}
+
+// 🧾 여정 플래그 (run, watch, serve, search 공용)
+func addTripFlags(fs *flag.FlagSet) {
+	fs.StringVar(&runOptions.route, "route", "", "경로 프리셋 이름 (profile add-route로 저장, 환경변수 SRT_ROUTE)")
+	fs.StringVar(&runOptions.from, "from", "", "출발역 (예: 수서)")
+	fs.StringVar(&runOptions.to, "to", "", "도착역 (예: 부산)")
+	fs.StringVar(&runOptions.date, "date", "", "출발 날짜 (YYYYMMDD 또는 YYYY-MM-DD)")
+	fs.StringVar(&runOptions.deptTime, "dept-time", "", "열차 출발 시간 (HHMM 또는 HH:MM)")
+	fs.StringVar(&runOptions.arrivalTime, "arrival-time", "", "열차 도착 시간 (HHMM 또는 HH:MM)")
+}
+
+// 🧾 예약 작업 플래그 (여정 + 승객 + 브라우저)
+func addJobFlags(fs *flag.FlagSet) {
+	addTripFlags(fs)
+	fs.StringVar(&runOptions.passenger, "passenger", "", "승객 프로필 이름 (profile add-passenger로 저장, 환경변수 SRT_PASSENGER)")
+	fs.StringVar(&runOptions.credential, "credential", "", "보관함에 저장된 자격 증명 이름 (vault add로 저장, 환경변수 SRT_CREDENTIAL)")
+	fs.IntVar(&runOptions.priority, "priority", 0, "같은 열차를 노리는 다른 작업보다 먼저 빈자리를 잡을 우선순위 (클수록 먼저)")
+	fs.Bool("headless", false, "브라우저 창 없이 실행 (설정 browser.headless, 환경변수 BROWSER_HEADLESS)")
+}
+
+// 명령의 플래그 집합을 만드는 함수 (모든 명령에 --config, 브라우저 명령에 --selectors)
+func newCommandFlagSet(cmd cliCommand) *flag.FlagSet {
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(os.Stdout)
+	if !cmd.noConfig {
+		fs.StringVar(&runOptions.configPath, "config", "", "YAML 설정 파일 경로 (기본: config.yaml, 환경변수 CONFIG_FILE)")
+	}
+	if cmd.browser {
+		fs.StringVar(&runOptions.selectors, "selectors", "", "셀렉터 프로필 파일 경로 (기본: 내장 프로필, 환경변수 SELECTORS_FILE)")
+	}
+	if cmd.flags != nil {
+		cmd.flags(fs)
+	}
+
+	fs.Usage = func() {
+		usage := "srt-lurker " + cmd.name
+		if hasFlags(fs) {
+			usage += " [플래그]"
+		}
+		if cmd.args != "" {
+			usage += " " + cmd.args
+		}
+		fmt.Printf("사용법: %s\n\n%s\n", usage, cmd.summary)
+		if hasFlags(fs) {
+			fmt.Println("\n플래그:")
+			fs.PrintDefaults()
+		}
+	}
+	return fs
+}
+
+func hasFlags(fs *flag.FlagSet) bool {
+	found := false
+	fs.VisitAll(func(*flag.Flag) { found = true })
+	return found
+}
+
+// 명령행에서 직접 지정한 플래그인지 (기본값과 구분)
+func flagWasSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// 📖 전체 도움말
+func printUsage(commands []cliCommand) {
+	fmt.Println("사용법: srt-lurker <명령> [플래그] [인자]")
+	fmt.Println()
+	fmt.Println("명령:")
+	for _, cmd := range commands {
+		fmt.Printf("  %-9s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Println()
+	fmt.Println("명령 없이 실행하면 입력 마법사에서 자동 예약/빈자리 감시를 골라 시작해요 (run 플래그 사용 가능)")
+	fmt.Println("명령별 플래그: srt-lurker help <명령> 또는 srt-lurker <명령> -h")
+	fmt.Println("종료 코드: 0 성공, 1 실패, 2 잘못된 명령/플래그, 130 중단")
+}
+
+func findCommand(commands []cliCommand, name string) (cliCommand, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return cliCommand{}, false
+}
+
+// 🧭 명령행을 해석해 하위 명령을 실행하는 함수 (종료 코드 반환)
+func runCLI(args []string) int {
+	commands := cliCommands()
+
+	var cmd cliCommand
+	legacy := false
+	switch {
+	case len(args) > 0 && (args[0] == "-h" || args[0] == "-help" || args[0] == "--help"):
+		printUsage(commands)
+		return exitOK
+	case len(args) == 0 || strings.HasPrefix(args[0], "-"):
+		// 명령 없이 실행: 입력 마법사에서 실행 모드부터 선택 (이전 버전과 같은 동작)
+		cmd, _ = findCommand(commands, "run")
+		cmd.run = func(fs *flag.FlagSet) int { return runInteractiveJob() }
+		legacy = true
+	default:
+		found := false
+		if cmd, found = findCommand(commands, args[0]); !found {
+			fmt.Printf("❌ 알 수 없는 명령이에요: %s\n\n", args[0])
+			printUsage(commands)
+			return exitUsage
+		}
+		args = args[1:]
+	}
+
+	fs := newCommandFlagSet(cmd)
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if legacy && fs.NArg() > 0 {
+		fmt.Printf("❌ 명령은 플래그보다 앞에 적어주세요 (예: srt-lurker %s --config my.yaml)\n", fs.Arg(0))
+		return exitUsage
+	}
+
+	if cmd.name == "help" {
+		if fs.NArg() == 0 {
+			printUsage(commands)
+			return exitOK
+		}
+		target, found := findCommand(commands, fs.Arg(0))
+		if !found {
+			fmt.Printf("❌ 알 수 없는 명령이에요: %s\n", fs.Arg(0))
+			return exitUsage
+		}
+		newCommandFlagSet(target).Usage()
+		return exitOK
+	}
+	if cmd.noConfig {
+		return cmd.run(fs)
+	}
+
+	loadConfig(os.Stdout)
+
+	// 명령행 플래그 > 환경변수 > 설정 파일
+	if flagWasSet(fs, "headless") {
+		browserConfig.headless = fs.Lookup("headless").Value.String() == "true"
+	}
+	if runOptions.selectors == "" {
+		runOptions.selectors = os.Getenv("SELECTORS_FILE")
+	}
+	if runOptions.credential == "" {
+		runOptions.credential = os.Getenv("SRT_CREDENTIAL")
+	}
+	if runOptions.passenger == "" {
+		runOptions.passenger = os.Getenv("SRT_PASSENGER")
+	}
+	if runOptions.route == "" {
+		runOptions.route = os.Getenv("SRT_ROUTE")
+	}
+
+	if cmd.strict {
+		if problems := validateConfig(); len(problems) > 0 {
+			printConfigProblems(problems)
+			fmt.Println("💡 설정을 고친 뒤 'config validate'로 다시 확인해주세요")
+			return exitFailure
+		}
+	}
+	if cmd.browser {
+		if err := loadSelectorProfile(runOptions.selectors, os.Stdout); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return exitFailure
+		}
+	}
+
+	// 🔐 접근 제어 검증 (access 명령은 첫 키 등록만 인증 없이 할 수 있도록 직접 검증)
+	if cmd.access && !checkAccess() {
+		return exitFailure
+	}
+
+	return cmd.run(fs)
+}
@@ -0,0 +1,830 @@
+package lurker
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// ⚙️ 설정 구조체
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// ⚙️ 아래 설정 구조체들의 기본값은 config.default.yaml 한 곳에 정리되어 있어요
+// (loadConfig가 기본값 → config.yaml → 환경변수 순서로 채움)
+
+// 🌐 브라우저 설정 구조체
+var browserConfig struct {
+	headless bool
+	slowMo   time.Duration // Playwright 동작 사이 지연 (디버깅용)
+}
+
+// 🔁 재시도 설정 구조체
+var retryConfig struct {
+	maxAttempts    int // 최대 예약 시도 횟수
+	delay          int // 실패 후 다음 시도까지 대기 (초)
+	watchInterval  int // 감시 모드 조회 간격 (초)
+	milestoneEvery int // 몇 번째 시도마다 진행 알림을 보낼지 (0: 끔)
+}
+
+// 📧 이메일 설정 구조체
+var emailConfig struct {
+	smtpHost    string
+	smtpPort    string
+	senderEmail string
+	senderPass  string
+	tlsMode     string        // "auto", "implicit"(SMTPS), "starttls"(필수), "none"(로컬 테스트용)
+	timeout     time.Duration // 연결부터 발송 완료까지 제한 시간
+}
+
+// 📬 알림 수신자와 이벤트별 라우팅 설정 구조체
+var notificationRouting = struct {
+	emailTo       []string                                  // 모든 메일 알림의 기본 수신자 (입력한 주소에 추가)
+	emailCC       []string                                  // 참조
+	emailBCC      []string                                  // 숨은 참조
+	emailRoutes   map[notificationEventType][]string        // 이벤트별 수신자 (지정 시 기본 수신자 대신 사용)
+	channelEvents map[string]map[notificationEventType]bool // 채널별로 보낼 이벤트 (없으면 채널 기본값)
+}{
+	emailRoutes:   map[notificationEventType][]string{},
+	channelEvents: map[string]map[notificationEventType]bool{},
+}
+
+// 채널별 기본 이벤트 (NOTIFY_EVENTS_<채널>로 변경 가능)
+var defaultChannelEvents = map[string][]notificationEventType{
+	"email":    {eventSeatFound, eventReserved, eventFailed, eventAborted},
+	"slack":    allNotificationEvents,
+	"discord":  allNotificationEvents,
+	"telegram": allNotificationEvents,
+	"webhook":  allNotificationEvents,
+	"desktop":  {eventSeatFound, eventReserved, eventFailed, eventAborted},
+}
+
+// 📝 알림 템플릿 재정의 폴더 (<이벤트>.tmpl, 비어 있으면 내장 템플릿만 사용)
+var notificationTemplateDir = ""
+
+// 💬 웹훅 알림 설정 구조체
+var webhookConfig struct {
+	slackURL     string
+	discordURL   string
+	genericURL   string
+	templatePath string // 일반 웹훅 JSON 페이로드 템플릿 파일 (비어 있으면 기본 템플릿)
+}
+
+// 🤖 텔레그램 봇 설정 구조체
+var telegramConfig struct {
+	botToken string
+	chatID   string
+	apiBase  string // 로컬 스텁으로 테스트할 때 바꿀 수 있음
+}
+
+// 🖥️ 로컬 알림 설정 구조체 (터미널 벨, 깜빡이는 배너, 데스크톱 알림)
+var desktopConfig struct {
+	enabled bool
+	system  bool // notify-send / osascript 데스크톱 알림도 띄울지 여부
+}
+
+// 📰 주기적 진행 요약 설정 구조체 (둘 다 0이면 요약을 보내지 않음)
+var digestConfig struct {
+	interval time.Duration // 이 시간마다 요약
+	attempts int           // 이 시도 횟수마다 요약
+}
+
+// 📅 예약 캘린더(.ics) 파일 설정 구조체
+var calendarConfig struct {
+	enabled bool
+	dir     string
+}
+
+// 🗂️ 실패 분석용 아티팩트 설정 구조체
+var artifactConfig struct {
+	enabled bool
+	dir     string
+	keep    int  // 보관할 최근 시도 폴더 수 (0 이하: 무제한)
+	trace   bool // Playwright trace zip 저장 여부
+}
+
+// 🧵 여러 작업 동시 실행 설정 구조체 (jobs 명령)
+var jobsConfig struct {
+	maxConcurrent int    // 동시에 실행할 작업 수 (모든 작업이 브라우저 하나를 나눠 씀)
+	logDir        string // 작업별 로그 폴더 (비어 있으면 상태 폴더/jobs)
+}
+
+// 🤝 같은 열차를 노리는 작업끼리 조율 설정 구조체 (상태 폴더/hunts)
+var coordinationConfig struct {
+	enabled bool
+}
+
+// 🙈 개인정보 가리기 설정 구조체 (로컬 디버깅 시 REDACT_PII=false)
+var redactConfig struct {
+	enabled bool
+}
+
+// 🗄️ 상태 폴더 (비어 있으면 사용자 설정 폴더/srt-lurker)
+var stateDirOverride = ""
+
+// 🔐 접근 제어 설정 구조체
+var accessConfig struct {
+	isPublic    bool
+	accessKey   string // 단일 접근 키 (bcrypt 해시 권장, 평문은 하위 호환용)
+	keysFile    string // 이름/해시/만료일이 있는 접근 키 파일 (비어 있으면 상태 폴더/access_keys)
+	maxAttempts int    // 이 횟수만큼 연속으로 틀리면 잠금
+	lockoutBase time.Duration
+	lockoutMax  time.Duration
+}
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// ⚙️ 설정 로드 함수
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 📂 설정 파일을 찾는 경로 (앞에 있을수록 우선)
+// SRT_LURKER_CONFIG → 실행 파일 폴더/.env → 현재 폴더/.env → 사용자 설정 폴더/config.env → XDG_CONFIG_DIRS
+func configSearchPaths() []string {
+	var paths []string
+	if path := os.Getenv("SRT_LURKER_CONFIG"); path != "" {
+		paths = append(paths, path)
+	}
+	if execPath, err := os.Executable(); err == nil {
+		paths = append(paths, filepath.Join(filepath.Dir(execPath), ".env"))
+	}
+	paths = append(paths, ".env")
+	if path := userConfigPath(); path != "" {
+		paths = append(paths, path)
+	}
+	if runtime.GOOS != "windows" {
+		systemDirs := os.Getenv("XDG_CONFIG_DIRS")
+		if systemDirs == "" {
+			systemDirs = "/etc/xdg"
+		}
+		for _, dir := range filepath.SplitList(systemDirs) {
+			paths = append(paths, filepath.Join(dir, "srt-lurker", "config.env"))
+		}
+	}
+	return paths
+}
+
+// 사용자 설정 파일 경로 (Linux: $XDG_CONFIG_HOME 또는 ~/.config, macOS: ~/Library/Application Support, Windows: %AppData%)
+func userConfigPath() string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(configDir, "srt-lurker", "config.env")
+}
+
+// 사용자 설정 폴더의 config.yaml (setup 명령이 만드는 파일)
+func userYAMLConfigPath() string {
+	path := userConfigPath()
+	if path == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(path), "config.yaml")
+}
+
+//go:embed config.default.yaml
+var defaultConfigFile []byte
+
+// 📄 YAML 설정 파일 구조체 (키 이름, 기본값, 대응하는 환경변수는 config.default.yaml 참고)
+type configFile struct {
+	Browser struct {
+		Headless bool `yaml:"headless"`
+		SlowMoMs int  `yaml:"slowMoMs"`
+	} `yaml:"browser"`
+	Retry struct {
+		MaxAttempts          int `yaml:"maxAttempts"`
+		DelaySeconds         int `yaml:"delaySeconds"`
+		WatchIntervalSeconds int `yaml:"watchIntervalSeconds"`
+		MilestoneEvery       int `yaml:"milestoneEvery"`
+	} `yaml:"retry"`
+	Notification struct {
+		TemplateDir string              `yaml:"templateDir"`
+		Events      map[string][]string `yaml:"events"`
+		Email       struct {
+			SMTPHost       string              `yaml:"smtpHost"`
+			SMTPPort       string              `yaml:"smtpPort"`
+			Sender         string              `yaml:"sender"`
+			Password       string              `yaml:"password"`
+			TLSMode        string              `yaml:"tlsMode"`
+			TimeoutSeconds int                 `yaml:"timeoutSeconds"`
+			To             []string            `yaml:"to"`
+			CC             []string            `yaml:"cc"`
+			BCC            []string            `yaml:"bcc"`
+			Routes         map[string][]string `yaml:"routes"`
+		} `yaml:"email"`
+		Slack struct {
+			WebhookURL string `yaml:"webhookURL"`
+		} `yaml:"slack"`
+		Discord struct {
+			WebhookURL string `yaml:"webhookURL"`
+		} `yaml:"discord"`
+		Webhook struct {
+			URL      string `yaml:"url"`
+			Template string `yaml:"template"`
+		} `yaml:"webhook"`
+		Telegram struct {
+			BotToken string `yaml:"botToken"`
+			ChatID   string `yaml:"chatID"`
+			APIBase  string `yaml:"apiBase"`
+		} `yaml:"telegram"`
+		Desktop struct {
+			Enabled bool `yaml:"enabled"`
+			System  bool `yaml:"system"`
+		} `yaml:"desktop"`
+		Digest struct {
+			IntervalMinutes int `yaml:"intervalMinutes"`
+			EveryAttempts   int `yaml:"everyAttempts"`
+		} `yaml:"digest"`
+		Calendar struct {
+			Enabled bool   `yaml:"enabled"`
+			Dir     string `yaml:"dir"`
+		} `yaml:"calendar"`
+	} `yaml:"notification"`
+	Access struct {
+		PublicMode        bool   `yaml:"publicMode"`
+		AccessKey         string `yaml:"accessKey"`
+		KeysFile          string `yaml:"keysFile"`
+		MaxAttempts       int    `yaml:"maxAttempts"`
+		LockoutSeconds    int    `yaml:"lockoutSeconds"`
+		LockoutMaxSeconds int    `yaml:"lockoutMaxSeconds"`
+	} `yaml:"access"`
+	Jobs struct {
+		MaxConcurrent int    `yaml:"maxConcurrent"`
+		LogDir        string `yaml:"logDir"`
+	} `yaml:"jobs"`
+	Coordination struct {
+		Enabled bool `yaml:"enabled"`
+	} `yaml:"coordination"`
+	Logging struct {
+		RedactPII bool   `yaml:"redactPII"`
+		StateDir  string `yaml:"stateDir"`
+		Artifacts struct {
+			Enabled bool   `yaml:"enabled"`
+			Dir     string `yaml:"dir"`
+			Keep    int    `yaml:"keep"`
+			Trace   bool   `yaml:"trace"`
+		} `yaml:"artifacts"`
+	} `yaml:"logging"`
+}
+
+// 📋 설정을 읽으며 발견한 문제 (실행 전에 한꺼번에 보고)
+var configProblems []string
+
+// 읽은 YAML 설정 파일 경로 (없으면 빈 문자열)
+var loadedConfigFile = ""
+
+func addConfigProblem(format string, args ...any) {
+	configProblems = append(configProblems, fmt.Sprintf(format, args...))
+}
+
+// 📂 YAML 설정 파일 경로 (--config → CONFIG_FILE → 실행 파일 폴더 → 현재 폴더 → 사용자 설정 폴더의 config.yaml)
+// 직접 지정한 파일은 없으면 문제로 보고하고, 찾아본 경로에 없으면 빈 문자열을 돌려줘요
+func yamlConfigPath() string {
+	if path := firstNonEmpty(runOptions.configPath, os.Getenv("CONFIG_FILE")); path != "" {
+		if _, err := os.Stat(path); err != nil {
+			addConfigProblem("설정 파일을 찾을 수 없어요: %s", path)
+			return ""
+		}
+		return path
+	}
+
+	var candidates []string
+	if execPath, err := os.Executable(); err == nil {
+		candidates = append(candidates, filepath.Join(filepath.Dir(execPath), "config.yaml"))
+	}
+	candidates = append(candidates, "config.yaml")
+	if path := userYAMLConfigPath(); path != "" {
+		candidates = append(candidates, path)
+	}
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+var unknownConfigKeyPattern = regexp.MustCompile(`^(line \d+: )field (\S+) not found in type`)
+
+// 📖 YAML 설정을 cfg 위에 덮어써 읽는 함수 (적지 않은 키는 기존 값 유지)
+// 알 수 없는 키와 형식이 맞지 않는 값은 모두 문제 목록에 모아요
+func decodeConfigFile(name string, data []byte, cfg *configFile) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	err := decoder.Decode(cfg)
+	if err == nil || errors.Is(err, io.EOF) {
+		return
+	}
+
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		for _, message := range typeErr.Errors {
+			// "line 3: field slowMo not found in type struct {...}" → 알 수 없는 키
+			if match := unknownConfigKeyPattern.FindStringSubmatch(message); match != nil {
+				message = fmt.Sprintf("%s알 수 없는 키예요: %s", match[1], match[2])
+			}
+			addConfigProblem("%s: %s", name, message)
+		}
+		return
+	}
+	addConfigProblem("%s: YAML 형식이 올바르지 않아요: %v", name, err)
+}
+
+// ⚙️ 읽은 YAML 설정을 각 설정 구조체에 반영하는 함수
+func applyConfigFile(cfg configFile) {
+	browserConfig.headless = cfg.Browser.Headless
+	browserConfig.slowMo = time.Duration(cfg.Browser.SlowMoMs) * time.Millisecond
+
+	retryConfig.maxAttempts = cfg.Retry.MaxAttempts
+	retryConfig.delay = cfg.Retry.DelaySeconds
+	retryConfig.watchInterval = cfg.Retry.WatchIntervalSeconds
+	retryConfig.milestoneEvery = cfg.Retry.MilestoneEvery
+
+	notification := cfg.Notification
+	notificationTemplateDir = notification.TemplateDir
+	for channel, names := range notification.Events {
+		channel = strings.ToLower(channel)
+		if _, ok := defaultChannelEvents[channel]; !ok {
+			addConfigProblem("notification.events: 알 수 없는 채널이에요: %s", channel)
+			continue
+		}
+		notificationRouting.channelEvents[channel] = parseEventNames("notification.events."+channel, names)
+	}
+
+	emailConfig.smtpHost = notification.Email.SMTPHost
+	emailConfig.smtpPort = notification.Email.SMTPPort
+	emailConfig.senderEmail = notification.Email.Sender
+	emailConfig.senderPass = notification.Email.Password
+	emailConfig.tlsMode = strings.ToLower(notification.Email.TLSMode)
+	emailConfig.timeout = time.Duration(notification.Email.TimeoutSeconds) * time.Second
+	notificationRouting.emailTo = filterEmails("notification.email.to", notification.Email.To)
+	notificationRouting.emailCC = filterEmails("notification.email.cc", notification.Email.CC)
+	notificationRouting.emailBCC = filterEmails("notification.email.bcc", notification.Email.BCC)
+	for name, recipients := range notification.Email.Routes {
+		source := "notification.email.routes." + name
+		events := parseEventNames(source, []string{name})
+		for eventType := range events {
+			notificationRouting.emailRoutes[eventType] = filterEmails(source, recipients)
+		}
+	}
+
+	webhookConfig.slackURL = notification.Slack.WebhookURL
+	webhookConfig.discordURL = notification.Discord.WebhookURL
+	webhookConfig.genericURL = notification.Webhook.URL
+	webhookConfig.templatePath = notification.Webhook.Template
+
+	telegramConfig.botToken = notification.Telegram.BotToken
+	telegramConfig.chatID = notification.Telegram.ChatID
+	telegramConfig.apiBase = strings.TrimRight(notification.Telegram.APIBase, "/")
+
+	desktopConfig.enabled = notification.Desktop.Enabled
+	desktopConfig.system = notification.Desktop.System
+
+	digestConfig.interval = time.Duration(notification.Digest.IntervalMinutes) * time.Minute
+	digestConfig.attempts = notification.Digest.EveryAttempts
+
+	calendarConfig.enabled = notification.Calendar.Enabled
+	calendarConfig.dir = notification.Calendar.Dir
+
+	accessConfig.isPublic = cfg.Access.PublicMode
+	accessConfig.accessKey = cfg.Access.AccessKey
+	accessConfig.keysFile = cfg.Access.KeysFile
+	accessConfig.maxAttempts = cfg.Access.MaxAttempts
+	accessConfig.lockoutBase = time.Duration(cfg.Access.LockoutSeconds) * time.Second
+	accessConfig.lockoutMax = time.Duration(cfg.Access.LockoutMaxSeconds) * time.Second
+
+	jobsConfig.maxConcurrent = cfg.Jobs.MaxConcurrent
+	jobsConfig.logDir = cfg.Jobs.LogDir
+	coordinationConfig.enabled = cfg.Coordination.Enabled
+
+	redactConfig.enabled = cfg.Logging.RedactPII
+	stateDirOverride = cfg.Logging.StateDir
+	artifactConfig.enabled = cfg.Logging.Artifacts.Enabled
+	artifactConfig.dir = cfg.Logging.Artifacts.Dir
+	artifactConfig.keep = cfg.Logging.Artifacts.Keep
+	artifactConfig.trace = cfg.Logging.Artifacts.Trace
+}
+
+// 🔢 정수 환경변수를 읽는 함수 (숫자가 아니면 문제로 기록하고 기존 값 유지)
+func envInt(key string, target *int) {
+	value := os.Getenv(key)
+	if value == "" {
+		return
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		addConfigProblem("%s 값이 숫자가 아니에요: %s", key, value)
+		return
+	}
+	*target = n
+}
+
+// ⏱️ 초/분/밀리초 단위 정수 환경변수를 시간으로 읽는 함수
+func envDuration(key string, unit time.Duration, target *time.Duration) {
+	n := int(*target / unit)
+	envInt(key, &n)
+	*target = time.Duration(n) * unit
+}
+
+// ✅ 최종 설정 값을 점검해 문제를 모두 모으는 함수 (읽는 중 발견한 문제 포함)
+func validateConfig() []string {
+	problems := append([]string(nil), configProblems...)
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	// 브라우저와 재시도
+	check(browserConfig.slowMo >= 0, "browser.slowMoMs는 0 이상이어야 해요")
+	check(retryConfig.maxAttempts >= 1, "retry.maxAttempts는 1 이상이어야 해요 (지금: %d)", retryConfig.maxAttempts)
+	check(retryConfig.delay >= 0, "retry.delaySeconds는 0 이상이어야 해요 (지금: %d)", retryConfig.delay)
+	check(retryConfig.watchInterval >= 1, "retry.watchIntervalSeconds는 1 이상이어야 해요 (지금: %d)", retryConfig.watchInterval)
+	check(retryConfig.milestoneEvery >= 0, "retry.milestoneEvery는 0 이상이어야 해요 (지금: %d)", retryConfig.milestoneEvery)
+	check(jobsConfig.maxConcurrent >= 1, "jobs.maxConcurrent는 1 이상이어야 해요 (지금: %d)", jobsConfig.maxConcurrent)
+
+	// 이메일 (발신 정보를 하나라도 적었으면 나머지도 필요)
+	switch emailConfig.tlsMode {
+	case "auto", "starttls", "implicit", "none":
+	default:
+		problems = append(problems, fmt.Sprintf("notification.email.tlsMode는 auto, starttls, implicit, none 중 하나여야 해요 (지금: %s)", emailConfig.tlsMode))
+	}
+	check(emailConfig.timeout > 0, "notification.email.timeoutSeconds는 1 이상이어야 해요")
+	if emailConfig.smtpHost != "" || emailConfig.senderEmail != "" || emailConfig.senderPass != "" {
+		check(emailConfig.smtpHost != "", "이메일 알림에 SMTP 서버(notification.email.smtpHost)가 필요해요")
+		check(emailConfig.senderEmail != "", "이메일 알림에 발신 주소(notification.email.sender)가 필요해요")
+		check(emailConfig.senderEmail == "" || emailFormatPattern.MatchString(emailConfig.senderEmail),
+			"notification.email.sender 값이 올바른 이메일 주소가 아니에요: %s", emailConfig.senderEmail)
+		port, err := strconv.Atoi(emailConfig.smtpPort)
+		check(err == nil && port > 0 && port <= 65535,
+			"notification.email.smtpPort는 1~65535 사이의 숫자여야 해요 (지금: %q)", emailConfig.smtpPort)
+	}
+
+	// 채팅/웹훅 주소
+	for _, endpoint := range []struct{ name, value string }{
+		{"notification.slack.webhookURL", webhookConfig.slackURL},
+		{"notification.discord.webhookURL", webhookConfig.discordURL},
+		{"notification.webhook.url", webhookConfig.genericURL},
+		{"notification.telegram.apiBase", telegramConfig.apiBase},
+	} {
+		if endpoint.value == "" {
+			continue
+		}
+		parsed, err := url.Parse(endpoint.value)
+		check(err == nil && (parsed.Scheme == "https" || parsed.Scheme == "http") && parsed.Host != "",
+			"%s 값이 올바른 http(s) 주소가 아니에요: %s", endpoint.name, redact(endpoint.value))
+	}
+	if webhookConfig.genericURL != "" || webhookConfig.templatePath != "" {
+		if _, err := newWebhookNotifier(webhookConfig.genericURL, webhookConfig.templatePath); err != nil {
+			problems = append(problems, fmt.Sprintf("notification.webhook.template: %v", err))
+		}
+	}
+	check((telegramConfig.botToken == "") == (telegramConfig.chatID == ""),
+		"텔레그램 알림에는 botToken과 chatID가 모두 필요해요")
+	if telegramConfig.chatID != "" && !strings.HasPrefix(telegramConfig.chatID, "@") {
+		_, err := strconv.ParseInt(telegramConfig.chatID, 10, 64)
+		check(err == nil, "notification.telegram.chatID는 숫자나 @채널이름이어야 해요 (지금: %s)", telegramConfig.chatID)
+	}
+
+	// 알림 템플릿 (재정의 폴더의 파일도 미리 파싱해 봄)
+	if notificationTemplateDir != "" {
+		info, err := os.Stat(notificationTemplateDir)
+		check(err == nil && info.IsDir(), "notification.templateDir 폴더를 찾을 수 없어요: %s", notificationTemplateDir)
+	}
+	for _, eventType := range allNotificationEvents {
+		source, err := notificationTemplateSource(eventType)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s 알림 템플릿: %v", eventType, err))
+			continue
+		}
+		tmpl, err := texttemplate.New(string(eventType)).Parse(source)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s 알림 템플릿 형식이 올바르지 않아요: %v", eventType, err))
+			continue
+		}
+		for _, block := range []string{"subject", "text", "html"} {
+			check(tmpl.Lookup(block) != nil, "%s 알림 템플릿에 %q 블록이 없어요", eventType, block)
+		}
+	}
+
+	// 진행 요약, 캘린더, 아티팩트
+	check(digestConfig.interval >= 0, "notification.digest.intervalMinutes는 0 이상이어야 해요")
+	check(digestConfig.attempts >= 0, "notification.digest.everyAttempts는 0 이상이어야 해요")
+	check(!calendarConfig.enabled || calendarConfig.dir != "", "notification.calendar.dir 값이 비어 있어요")
+	check(!artifactConfig.enabled || artifactConfig.dir != "", "logging.artifacts.dir 값이 비어 있어요")
+
+	// 접근 제어
+	check(accessConfig.maxAttempts >= 1, "access.maxAttempts는 1 이상이어야 해요 (지금: %d)", accessConfig.maxAttempts)
+	check(accessConfig.lockoutBase > 0, "access.lockoutSeconds는 1 이상이어야 해요")
+	check(accessConfig.lockoutMax >= accessConfig.lockoutBase, "access.lockoutMaxSeconds는 lockoutSeconds보다 작을 수 없어요")
+	if accessConfig.keysFile != "" {
+		_, err := os.Stat(accessConfig.keysFile)
+		check(err == nil, "access.keysFile 파일을 찾을 수 없어요: %s", accessConfig.keysFile)
+	}
+
+	// 승객 프로필과 경로 프리셋
+	if book, err := loadProfiles(); err != nil {
+		problems = append(problems, err.Error())
+	} else {
+		problems = append(problems, validateProfiles(book)...)
+	}
+
+	// 비밀 값이 든 설정 파일은 이 컴퓨터의 사용자만 읽을 수 있어야 함
+	if loadedConfigFile != "" && runtime.GOOS != "windows" &&
+		(emailConfig.senderPass != "" || telegramConfig.botToken != "" || accessConfig.accessKey != "") {
+		if info, err := os.Stat(loadedConfigFile); err == nil && info.Mode().Perm()&0o077 != 0 {
+			problems = append(problems, fmt.Sprintf("설정 파일(%s)을 다른 사용자도 읽을 수 있어요. chmod 600으로 권한을 줄여주세요", loadedConfigFile))
+		}
+	}
+
+	return problems
+}
+
+// 🧾 설정 문제 목록을 출력하는 함수
+func printConfigProblems(problems []string) {
+	fmt.Printf("❌ 설정에서 문제 %d건을 찾았어요:\n", len(problems))
+	for _, problem := range problems {
+		fmt.Printf("   - %s\n", problem)
+	}
+}
+
+// 🛠️ config 명령 (validate: 설정 점검, defaults: 기본 설정 파일 출력, path: 읽은 설정 파일 경로)
+func runConfigCommand(args []string) int {
+	command := ""
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	switch command {
+	case "validate":
+		problems := validateConfig()
+		if len(problems) > 0 {
+			printConfigProblems(problems)
+			return exitFailure
+		}
+		fmt.Println("✅ 설정에 문제가 없어요")
+		return exitOK
+	case "defaults":
+		fmt.Print(string(defaultConfigFile))
+		return exitOK
+	case "path":
+		if loadedConfigFile == "" {
+			fmt.Println("ℹ️ 읽은 YAML 설정 파일이 없어요 (기본값과 환경변수만 사용)")
+			return exitOK
+		}
+		fmt.Println(loadedConfigFile)
+		return exitOK
+	default:
+		fmt.Println("사용법: config validate | config defaults | config path")
+		fmt.Println("  validate   기본값, config.yaml, 환경변수를 합친 최종 설정의 문제를 한꺼번에 보여줘요")
+		fmt.Println("  defaults   모든 설정과 기본값이 적힌 설정 파일을 출력해요 (config.yaml로 저장해 수정)")
+		fmt.Println("  path       읽은 YAML 설정 파일 경로를 보여줘요")
+		return exitUsage
+	}
+}
+
+// 설정을 읽으며 어떤 파일을 읽었는지 out에 써요
+func loadConfig(out io.Writer) {
+	// 다시 읽을 때 이전 파일의 문제와 라우팅이 남지 않도록 누적되는 값은 비우고 시작
+	configProblems = nil
+	loadedConfigFile = ""
+	notificationRouting.emailRoutes = map[notificationEventType][]string{}
+	notificationRouting.channelEvents = map[string]map[notificationEventType]bool{}
+
+	// 찾은 .env 파일을 모두 읽되, 이미 설정된 값은 덮어쓰지 않으므로 앞의 파일과 환경변수가 우선
+	loaded := map[string]bool{}
+	for _, path := range configSearchPaths() {
+		absPath, err := filepath.Abs(path)
+		if err != nil || loaded[absPath] {
+			continue
+		}
+		if _, err := os.Stat(absPath); err != nil {
+			continue
+		}
+		if err := godotenv.Load(absPath); err != nil {
+			addConfigProblem("설정 파일을 읽을 수 없어요 (%s): %v", absPath, err)
+			continue
+		}
+		loaded[absPath] = true
+		fmt.Fprintf(out, "✅ 설정 파일을 로드했어요: %s\n", absPath)
+	}
+
+	// 내장 기본값 위에 config.yaml을 덮어쓴 뒤 각 설정에 반영
+	var cfg configFile
+	decodeConfigFile("config.default.yaml", defaultConfigFile, &cfg)
+	if path := yamlConfigPath(); path != "" {
+		if data, err := os.ReadFile(path); err != nil {
+			addConfigProblem("설정 파일을 읽을 수 없어요 (%s): %v", path, err)
+		} else {
+			decodeConfigFile(path, data, &cfg)
+			loadedConfigFile = path
+			fmt.Fprintf(out, "✅ 설정 파일을 로드했어요: %s\n", path)
+		}
+	}
+	applyConfigFile(cfg)
+
+	if len(loaded) == 0 && loadedConfigFile == "" {
+		fmt.Fprintln(out, "⚠️ 설정 파일을 찾을 수 없어요. 기본값을 사용할게요")
+		fmt.Fprintln(out, "   ℹ️ 'setup' 명령으로 설정 파일을 만들 수 있어요")
+	}
+
+	// 여기부터는 환경변수가 있으면 설정 파일 값을 덮어씀
+
+	// 브라우저/재시도 설정 로드
+	if headless := os.Getenv("BROWSER_HEADLESS"); headless != "" {
+		browserConfig.headless = (headless == "true")
+	}
+	envDuration("BROWSER_SLOW_MO_MS", time.Millisecond, &browserConfig.slowMo)
+	envInt("RETRY_MAX_ATTEMPTS", &retryConfig.maxAttempts)
+	envInt("RETRY_DELAY_SECONDS", &retryConfig.delay)
+	envInt("WATCH_INTERVAL_SECONDS", &retryConfig.watchInterval)
+	envInt("MILESTONE_EVERY_ATTEMPTS", &retryConfig.milestoneEvery)
+
+	// 이메일 설정 로드
+	if host := os.Getenv("SMTP_HOST"); host != "" {
+		emailConfig.smtpHost = host
+	}
+	if port := os.Getenv("SMTP_PORT"); port != "" {
+		emailConfig.smtpPort = port
+	}
+	if email := os.Getenv("SENDER_EMAIL"); email != "" {
+		emailConfig.senderEmail = email
+	}
+	if pass := os.Getenv("SENDER_PASSWORD"); pass != "" {
+		emailConfig.senderPass = pass
+	}
+	if tlsMode := os.Getenv("SMTP_TLS_MODE"); tlsMode != "" {
+		emailConfig.tlsMode = strings.ToLower(tlsMode)
+	}
+	envDuration("SMTP_TIMEOUT", time.Second, &emailConfig.timeout)
+
+	// 알림 수신자/라우팅 설정 로드
+	if recipients := os.Getenv("NOTIFY_EMAIL_TO"); recipients != "" {
+		notificationRouting.emailTo = parseEmailList("NOTIFY_EMAIL_TO")
+	}
+	if recipients := os.Getenv("NOTIFY_EMAIL_CC"); recipients != "" {
+		notificationRouting.emailCC = parseEmailList("NOTIFY_EMAIL_CC")
+	}
+	if recipients := os.Getenv("NOTIFY_EMAIL_BCC"); recipients != "" {
+		notificationRouting.emailBCC = parseEmailList("NOTIFY_EMAIL_BCC")
+	}
+	for _, eventType := range allNotificationEvents {
+		key := "NOTIFY_ROUTE_" + strings.ToUpper(string(eventType))
+		if recipients := parseEmailList(key); len(recipients) > 0 {
+			notificationRouting.emailRoutes[eventType] = recipients
+		}
+	}
+	for channel := range defaultChannelEvents {
+		key := "NOTIFY_EVENTS_" + strings.ToUpper(channel)
+		if value := os.Getenv(key); value != "" {
+			notificationRouting.channelEvents[channel] = parseEventNames(key, splitList(value))
+		}
+	}
+
+	// 알림 템플릿 설정 로드
+	if templateDir := os.Getenv("NOTIFICATION_TEMPLATE_DIR"); templateDir != "" {
+		notificationTemplateDir = templateDir
+	}
+
+	// 웹훅 알림 설정 로드
+	if slackURL := os.Getenv("SLACK_WEBHOOK_URL"); slackURL != "" {
+		webhookConfig.slackURL = slackURL
+	}
+	if discordURL := os.Getenv("DISCORD_WEBHOOK_URL"); discordURL != "" {
+		webhookConfig.discordURL = discordURL
+	}
+	if genericURL := os.Getenv("WEBHOOK_URL"); genericURL != "" {
+		webhookConfig.genericURL = genericURL
+	}
+	if templatePath := os.Getenv("WEBHOOK_TEMPLATE"); templatePath != "" {
+		webhookConfig.templatePath = templatePath
+	}
+
+	// 텔레그램 봇 설정 로드
+	if botToken := os.Getenv("TELEGRAM_BOT_TOKEN"); botToken != "" {
+		telegramConfig.botToken = botToken
+	}
+	if chatID := os.Getenv("TELEGRAM_CHAT_ID"); chatID != "" {
+		telegramConfig.chatID = chatID
+	}
+	if apiBase := os.Getenv("TELEGRAM_API_BASE"); apiBase != "" {
+		telegramConfig.apiBase = strings.TrimRight(apiBase, "/")
+	}
+
+	// 로컬 알림 설정 로드
+	if enabled := os.Getenv("DESKTOP_NOTIFY"); enabled != "" {
+		desktopConfig.enabled = (enabled == "true")
+	}
+	if system := os.Getenv("DESKTOP_NOTIFY_SYSTEM"); system != "" {
+		desktopConfig.system = (system == "true")
+	}
+
+	// 진행 요약 설정 로드
+	envDuration("DIGEST_INTERVAL_MINUTES", time.Minute, &digestConfig.interval)
+	envInt("DIGEST_EVERY_ATTEMPTS", &digestConfig.attempts)
+
+	// 캘린더 설정 로드
+	if enabled := os.Getenv("CALENDAR_ENABLED"); enabled != "" {
+		calendarConfig.enabled = (enabled == "true")
+	}
+	if dir := os.Getenv("CALENDAR_DIR"); dir != "" {
+		calendarConfig.dir = dir
+	}
+
+	// 아티팩트 설정 로드
+	if enabled := os.Getenv("ARTIFACTS_ENABLED"); enabled != "" {
+		artifactConfig.enabled = (enabled == "true")
+	}
+	if dir := os.Getenv("ARTIFACTS_DIR"); dir != "" {
+		artifactConfig.dir = dir
+	}
+	envInt("ARTIFACTS_KEEP", &artifactConfig.keep)
+	if trace := os.Getenv("ARTIFACTS_TRACE"); trace != "" {
+		artifactConfig.trace = (trace == "true")
+	}
+
+	// 여러 작업 동시 실행 설정 로드
+	envInt("MAX_CONCURRENT_JOBS", &jobsConfig.maxConcurrent)
+	if dir := os.Getenv("JOBS_LOG_DIR"); dir != "" {
+		jobsConfig.logDir = dir
+	}
+
+	// 작업 조율 설정 로드
+	if enabled := os.Getenv("COORDINATION_ENABLED"); enabled != "" {
+		coordinationConfig.enabled = (enabled == "true")
+	}
+
+	// 개인정보 가리기/상태 폴더 설정 로드
+	if enabled := os.Getenv("REDACT_PII"); enabled != "" {
+		redactConfig.enabled = (enabled != "false")
+	}
+	if dir := os.Getenv("STATE_DIR"); dir != "" {
+		stateDirOverride = dir
+	}
+
+	// 접근 제어 설정 로드
+	if publicMode := os.Getenv("PUBLIC_MODE"); publicMode != "" {
+		accessConfig.isPublic = (publicMode == "true")
+	}
+	if accessKey := os.Getenv("ACCESS_KEY"); accessKey != "" {
+		accessConfig.accessKey = accessKey
+	}
+	if keysFile := os.Getenv("ACCESS_KEYS_FILE"); keysFile != "" {
+		accessConfig.keysFile = keysFile
+	}
+	envInt("ACCESS_MAX_ATTEMPTS", &accessConfig.maxAttempts)
+	envDuration("ACCESS_LOCKOUT_SECONDS", time.Second, &accessConfig.lockoutBase)
+	envDuration("ACCESS_LOCKOUT_MAX_SECONDS", time.Second, &accessConfig.lockoutMax)
+
+	fmt.Fprintln(out, "✅ 환경변수에서 보안 데이터 설정을 로드했어요")
+}
+
+// 📬 환경변수의 쉼표 구분 이메일 목록을 읽는 함수
+func parseEmailList(key string) []string {
+	return filterEmails(key, splitList(os.Getenv(key)))
+}
+
+// 📬 이메일 목록에서 잘못된 주소를 문제로 기록하고 제외하는 함수
+func filterEmails(source string, emails []string) []string {
+	var valid []string
+	for _, email := range emails {
+		email = strings.TrimSpace(email)
+		if !emailFormatPattern.MatchString(email) {
+			addConfigProblem("%s 값에 잘못된 이메일 주소가 있어요: %s", source, email)
+			continue
+		}
+		valid = append(valid, email)
+	}
+	return valid
+}
+
+// 📬 이벤트 이름 목록을 읽는 함수 (알 수 없는 이벤트는 문제로 기록하고 제외)
+func parseEventNames(source string, names []string) map[notificationEventType]bool {
+	known := map[notificationEventType]bool{}
+	for _, eventType := range allNotificationEvents {
+		known[eventType] = true
+	}
+
+	events := map[notificationEventType]bool{}
+	for _, name := range names {
+		eventType := notificationEventType(strings.ToLower(strings.TrimSpace(name)))
+		if !known[eventType] {
+			addConfigProblem("%s 값에 알 수 없는 이벤트가 있어요: %s", source, name)
+			continue
+		}
+		events[eventType] = true
+	}
+	return events
+}
@@ -0,0 +1,99 @@
+package lurker
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadConfigTwice(t *testing.T) {
+	savedStateDir := stateDirOverride
+	defer func() { stateDirOverride = savedStateDir }()
+
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.yaml")
+	second := filepath.Join(dir, "second.yaml")
+	os.WriteFile(first, []byte(`
+notification:
+  events:
+    slack: [reserved]
+    pager: [reserved]
+  email:
+    routes:
+      failed: [ops@example.com]
+logging:
+  stateDir: `+dir+`
+`), 0o600)
+	os.WriteFile(second, []byte(`
+logging:
+  stateDir: `+dir+`
+`), 0o600)
+
+	err := LoadConfig(first)
+	if err == nil || !strings.Contains(err.Error(), "pager") {
+		t.Fatalf("첫 설정의 오류 = %v, want 알 수 없는 채널 pager", err)
+	}
+	if len(notificationRouting.emailRoutes) == 0 || len(notificationRouting.channelEvents) == 0 {
+		t.Fatal("첫 설정의 라우팅이 반영되지 않았어요")
+	}
+
+	if err := LoadConfig(second); err != nil {
+		t.Fatalf("두 번째 설정에 이전 설정의 문제가 남았어요: %v", err)
+	}
+	if loadedConfigFile != second {
+		t.Errorf("읽은 설정 파일 = %q, want %q", loadedConfigFile, second)
+	}
+	if len(notificationRouting.emailRoutes) != 0 {
+		t.Errorf("이전 설정의 메일 라우팅이 남았어요: %v", notificationRouting.emailRoutes)
+	}
+	if !reflect.DeepEqual(notificationRouting.channelEvents, map[string]map[notificationEventType]bool{}) {
+		t.Errorf("이전 설정의 채널 이벤트가 남았어요: %v", notificationRouting.channelEvents)
+	}
+}
+
+func TestDefaultConfigLeavesDesktopOff(t *testing.T) {
+	var cfg configFile
+	decodeConfigFile("config.default.yaml", defaultConfigFile, &cfg)
+	if cfg.Notification.Desktop.Enabled || cfg.Notification.Desktop.System {
+		t.Errorf("로컬 알림이 기본으로 켜져 있어요: %+v", cfg.Notification.Desktop)
+	}
+}
+
+func TestSetupConfigYAML(t *testing.T) {
+	answers := setupAnswers{
+		publicMode:     false,
+		accessKey:      "$2a$10$abcdefghijklmnopqrstuv",
+		smtpHost:       "smtp.gmail.com",
+		smtpPort:       "587",
+		sender:         "me@example.com",
+		senderPassword: `p#ss: 'word" $HOME`,
+		slackURL:       "https://hooks.slack.com/services/T0/B0/x",
+		telegramToken:  "123:abc",
+		telegramChatID: "42",
+	}
+	saved := configProblems
+	configProblems = nil
+	defer func() { configProblems = saved }()
+
+	var cfg configFile
+	decodeConfigFile("config.default.yaml", defaultConfigFile, &cfg)
+	decodeConfigFile("setup", []byte(answers.configYAML(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))), &cfg)
+	if len(configProblems) > 0 {
+		t.Fatalf("setup이 만든 설정에 문제가 있어요: %v", configProblems)
+	}
+
+	got := []string{cfg.Access.AccessKey, cfg.Notification.Email.SMTPPort, cfg.Notification.Email.Password,
+		cfg.Notification.Slack.WebhookURL, cfg.Notification.Telegram.BotToken, cfg.Notification.Telegram.ChatID}
+	want := []string{answers.accessKey, answers.smtpPort, answers.senderPassword,
+		answers.slackURL, answers.telegramToken, answers.telegramChatID}
+	if cfg.Access.PublicMode || !reflect.DeepEqual(got, want) {
+		t.Errorf("publicMode=%v, 값 %q, want %q", cfg.Access.PublicMode, got, want)
+	}
+	if cfg.Notification.Discord.WebhookURL != "" || cfg.Notification.Email.TLSMode != "auto" {
+		t.Errorf("답하지 않은 항목은 기본값이어야 해요: discord=%q tlsMode=%q",
+			cfg.Notification.Discord.WebhookURL, cfg.Notification.Email.TLSMode)
+	}
+}
@@ -0,0 +1,112 @@
+package lurker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🎮 실행 상태와 원격 제어
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🎮 실행 중인 예약/감시 루프의 상태 (원격 명령이 읽고 바꿈, 작업마다 따로)
+type runControl struct {
+	sync.Mutex
+	startedAt time.Time
+	attempt   int
+	failures  int
+	lastError string
+	paused    bool
+	stop      bool
+}
+
+func (s *session) updateRunStatus(attempt int, lastError string) {
+	s.control.Lock()
+	defer s.control.Unlock()
+	s.control.attempt = attempt
+	if lastError != "" {
+		s.control.failures++
+		s.control.lastError = lastError
+	}
+}
+
+func (s *session) setPaused(paused bool) {
+	s.control.Lock()
+	defer s.control.Unlock()
+	s.control.paused = paused
+}
+
+func (s *session) requestStop() {
+	s.control.Lock()
+	defer s.control.Unlock()
+	s.control.stop = true
+	s.control.paused = false
+}
+
+func (s *session) stopRequested() bool {
+	s.control.Lock()
+	defer s.control.Unlock()
+	return s.control.stop
+}
+
+// ⏸️ 일시정지 상태인 동안 다음 시도를 미루는 함수
+func (s *session) waitWhilePaused() {
+	announced := false
+	for {
+		s.control.Lock()
+		paused := s.control.paused
+		s.control.Unlock()
+		if !paused || s.finished() {
+			if announced && !s.finished() {
+				s.println("   ▶ 원격 명령으로 다시 시작해요")
+			}
+			return
+		}
+		if !announced {
+			s.println("   ⏸️ 원격 명령으로 일시정지했어요 (/resume으로 재개)")
+			announced = true
+		}
+		s.sleep(1 * time.Second)
+	}
+}
+
+// 📊 현재 실행 상태 요약 (/status 응답)
+func (s *session) runStatusText() string {
+	s.control.Lock()
+	defer s.control.Unlock()
+
+	mode := "자동 예약"
+	if s.job.Mode == "watch" {
+		mode = "빈자리 감시"
+	}
+	state := "진행 중"
+	if s.control.stop {
+		state = "중지 요청됨"
+	} else if s.control.paused {
+		state = "일시정지"
+	}
+	lastError := s.control.lastError
+	if lastError == "" {
+		lastError = "(없음)"
+	}
+
+	if s.job.ID != "" {
+		mode += ", 작업 " + s.job.ID
+	}
+
+	return fmt.Sprintf(`🚄 SRT Lurker 상태
+- 모드: %s (%s)
+- 구간: %s (%s) → %s (%s)
+- 날짜: %s
+- 시도: %d회 (최대 %d회)
+- 경과: %s
+- 마지막 오류: %s`,
+		mode, state,
+		s.job.From, s.job.DeptTime,
+		s.job.To, s.job.ArrivalTime,
+		s.job.Date,
+		s.control.attempt, retryConfig.maxAttempts,
+		time.Since(s.control.startedAt).Round(time.Second),
+		lastError)
+}
@@ -0,0 +1,348 @@
+package lurker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🤝 같은 열차를 노리는 작업끼리 조율 (상태 폴더/hunts)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 같은 날짜, 구간, 출발/도착 시간(같은 열차)을 노리는 작업은 프로세스가 달라도 상태 폴더의
+// hunts/<여정 키>/ 폴더에 참여 기록을 남겨 서로를 알아봐요
+// - 우선순위가 가장 높은 작업(같으면 먼저 시작한 작업) 하나만 SRT 사이트를 조회하고 결과를 poll.json에 공유
+// - 나머지 감시 작업은 공유된 조회 결과를 그대로 쓰고, 예약 작업은 빈자리가 보였을 때만 우선순위 순서대로 예약을 시도
+// - 조회를 맡은 예약 작업은 4단계(열차 확인)와 5단계(예약하기)에서 읽은 좌석 상태를 모두 공유
+// 예약 순서는 순서마다 시작을 늦추는 것뿐이라 보장되지 않아요 (앞 작업이 늦어지면 뒤 작업이 먼저 누를 수 있음)
+
+const (
+	huntHeartbeatInterval = 15 * time.Second // 참여 기록을 갱신하는 간격
+	huntStaleAfter        = time.Minute      // 이 시간 동안 갱신되지 않은 참여 기록은 끝난 작업으로 보고 지움
+	huntSeatFresh         = 30 * time.Second // 이보다 오래된 조회 결과의 빈자리는 믿지 않음
+	huntSeatStagger       = 2 * time.Second  // 예약 우선순위 한 단계마다 늦게 시도하는 시간 (순서를 보장하지는 않음)
+)
+
+// 🙋 작업 하나의 참여 기록 (hunts/<여정 키>/claim-<ID>.json)
+type huntClaim struct {
+	ID        string    `json:"id"`
+	Job       string    `json:"job"` // 표시용 작업 이름 (Job.ID 또는 "pid <번호>")
+	PID       int       `json:"pid"`
+	Mode      string    `json:"mode"`
+	Priority  int       `json:"priority"`
+	StartedAt time.Time `json:"startedAt"`
+	Heartbeat time.Time `json:"heartbeat"`
+}
+
+// 📡 조회를 맡은 작업이 공유하는 조회 결과 (hunts/<여정 키>/poll.json)
+type sharedPoll struct {
+	PolledAt time.Time     `json:"polledAt"`
+	By       string        `json:"by"`
+	Trains   []sharedTrain `json:"trains"`
+}
+
+type sharedTrain struct {
+	TrainNumber string `json:"trainNumber"`
+	Dept        string `json:"dept"`
+	Arrival     string `json:"arrival"`
+	Premium     string `json:"premium"`
+	Standard    string `json:"standard"`
+}
+
+// 🤝 작업의 조율 상태 (조율을 끄면 nil)
+type huntCoordinator struct {
+	dir      string
+	claim    huntClaim
+	lastPoll time.Time     // 마지막으로 쓴 공유 조회 결과의 시각 (같은 결과를 두 번 쓰지 않도록)
+	stop     chan struct{} // 닫으면 참여 기록 갱신을 멈춤
+	stopped  chan struct{} // 참여 기록 갱신이 끝나면 닫힘
+}
+
+var huntClaimSeq atomic.Int64
+
+// 🗝️ 여정 키 (날짜, 구간, 출발/도착 시간이 모두 같으면 같은 열차)
+func huntKey(job Job) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{job.Date, job.From, job.To, job.DeptTime, job.ArrivalTime}, "|")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// 🙋 참여 기록을 남기고 같은 열차를 노리는 다른 작업이 있는지 알려주는 함수 (작업이 끝날 때까지 기록을 갱신)
+func (s *session) joinHunt() {
+	if !coordinationConfig.enabled {
+		return
+	}
+	dir := filepath.Join(stateDir(), "hunts", huntKey(s.job))
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		s.printf("   ⚠️ 작업 조율 폴더를 만들 수 없어 혼자 실행해요: %v\n", err)
+		return
+	}
+
+	now := time.Now()
+	label := s.job.ID
+	if label == "" {
+		label = fmt.Sprintf("pid %d", os.Getpid())
+	}
+	c := &huntCoordinator{dir: dir, stop: make(chan struct{}), stopped: make(chan struct{}), claim: huntClaim{
+		ID:        fmt.Sprintf("%d-%d", os.Getpid(), huntClaimSeq.Add(1)),
+		Job:       label,
+		PID:       os.Getpid(),
+		Mode:      s.job.Mode,
+		Priority:  s.job.Priority,
+		StartedAt: now,
+		Heartbeat: now,
+	}}
+	if err := writeJSONFile(c.claimPath(), c.claim); err != nil {
+		s.printf("   ⚠️ 작업 조율 기록을 남길 수 없어 혼자 실행해요: %v\n", err)
+		return
+	}
+	s.hunt = c
+
+	go func() {
+		defer close(c.stopped)
+		ticker := time.NewTicker(huntHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.claim.Heartbeat = time.Now()
+				writeJSONFile(c.claimPath(), c.claim)
+			case <-c.stop:
+				return
+			}
+		}
+	}()
+
+	members := c.members()
+	if len(members) <= 1 {
+		return
+	}
+	var others []string
+	for _, member := range members {
+		if member.ID != c.claim.ID {
+			others = append(others, fmt.Sprintf("%s(우선순위 %d)", member.Job, member.Priority))
+		}
+	}
+	s.printf("   🤝 같은 열차를 노리는 작업이 %d개 더 있어요: %s\n", len(others), strings.Join(others, ", "))
+	s.printf("   🤝 조회는 %s 작업이 맡고 결과를 함께 써요\n", members[0].Job)
+}
+
+// 🚪 참여 기록을 지우는 함수 (결과가 나오거나 작업이 끝날 때, 다음 순서의 작업이 조회를 이어받음)
+// 갱신을 먼저 멈춰야 지운 기록을 갱신 고루틴이 다시 쓰지 않아요. 여러 번 불러도 돼요
+func (s *session) leaveHunt() {
+	c := s.hunt
+	if c == nil {
+		return
+	}
+	s.hunt = nil
+	close(c.stop)
+	<-c.stopped
+	os.Remove(c.claimPath())
+
+	// 마지막 작업이 떠나면 공유 조회 결과도 지워서 다음 실행이 오래된 결과를 쓰지 않게 함
+	if len(c.members()) == 0 {
+		os.Remove(c.pollPath())
+	}
+}
+
+func (c *huntCoordinator) claimPath() string {
+	return filepath.Join(c.dir, "claim-"+c.claim.ID+".json")
+}
+
+// 👥 살아 있는 참여 작업 목록 (우선순위 높은 순, 같으면 먼저 시작한 순, 끝난 작업의 기록은 지움)
+func (c *huntCoordinator) members() []huntClaim {
+	paths, _ := filepath.Glob(filepath.Join(c.dir, "claim-*.json"))
+	var members []huntClaim
+	for _, path := range paths {
+		var claim huntClaim
+		data, err := os.ReadFile(path)
+		if err != nil || json.Unmarshal(data, &claim) != nil {
+			continue
+		}
+		if time.Since(claim.Heartbeat) > huntStaleAfter {
+			os.Remove(path)
+			continue
+		}
+		members = append(members, claim)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Priority != members[j].Priority {
+			return members[i].Priority > members[j].Priority
+		}
+		if !members[i].StartedAt.Equal(members[j].StartedAt) {
+			return members[i].StartedAt.Before(members[j].StartedAt)
+		}
+		return members[i].ID < members[j].ID
+	})
+	return members
+}
+
+// 🎖️ 조회를 맡은 작업과 이 작업의 예약 순서 (예약 작업 중 몇 번째인지, 0부터)
+func (c *huntCoordinator) roles() (poller huntClaim, seatRank int) {
+	members := c.members()
+	if len(members) == 0 {
+		return c.claim, 0
+	}
+	for _, member := range members {
+		if member.ID == c.claim.ID {
+			break
+		}
+		if member.Mode == "reserve" {
+			seatRank++
+		}
+	}
+	return members[0], seatRank
+}
+
+func (c *huntCoordinator) pollPath() string {
+	return filepath.Join(c.dir, "poll.json")
+}
+
+// 📡 아직 쓰지 않은 새 공유 조회 결과를 읽는 함수
+func (c *huntCoordinator) nextPoll() (sharedPoll, bool) {
+	var poll sharedPoll
+	data, err := os.ReadFile(c.pollPath())
+	if err != nil || json.Unmarshal(data, &poll) != nil || !poll.PolledAt.After(c.lastPoll) {
+		return poll, false
+	}
+	c.lastPoll = poll.PolledAt
+	return poll, true
+}
+
+// 📡 사이트에서 읽은 좌석 상태를 같은 열차를 노리는 작업들과 공유하는 함수
+func (s *session) shareTrains(trains []trainAvailability) {
+	if s.hunt == nil || len(trains) == 0 {
+		return
+	}
+	poll := sharedPoll{PolledAt: time.Now(), By: s.hunt.claim.Job}
+	for _, train := range trains {
+		poll.Trains = append(poll.Trains, sharedTrain{
+			TrainNumber: train.trainNumber,
+			Dept:        train.deptText,
+			Arrival:     train.arrivalText,
+			Premium:     train.premium,
+			Standard:    train.standard,
+		})
+	}
+	s.hunt.lastPoll = poll.PolledAt
+	if err := writeJSONFile(s.hunt.pollPath(), poll); err != nil {
+		s.printf("   ⚠️ 조회 결과를 공유하지 못했어요: %v\n", err)
+	}
+}
+
+func (poll sharedPoll) trains() []trainAvailability {
+	trains := make([]trainAvailability, 0, len(poll.Trains))
+	for _, train := range poll.Trains {
+		trains = append(trains, trainAvailability{
+			trainNumber: train.TrainNumber,
+			deptText:    train.Dept,
+			arrivalText: train.Arrival,
+			premium:     train.Premium,
+			standard:    train.Standard,
+		})
+	}
+	return trains
+}
+
+// 🎫 일반실 빈자리가 보인 열차 (예약하기는 일반실 버튼을 누름)
+func (poll sharedPoll) seatTrain() (sharedTrain, bool) {
+	for _, train := range poll.Trains {
+		if train.Standard == "예약가능" {
+			return train, true
+		}
+	}
+	return sharedTrain{}, false
+}
+
+// 🤝 예약 시도 전에 차례를 기다리는 함수
+// 조회를 맡았으면 바로 돌아오고, 아니면 공유 조회에서 빈자리가 보일 때 예약 순서만큼 늦게 돌아와요
+// 작업이 취소되거나 원격 중지 명령을 받아도 돌아와요
+func (s *session) waitForTurn() {
+	if s.hunt == nil {
+		return
+	}
+	waiting := ""
+	for !s.finished() && !s.stopRequested() {
+		// 차례를 기다리는 동안에도 진행 요약은 보냄
+		s.maybeSendDigest()
+		poller, seatRank := s.hunt.roles()
+		if poller.ID == s.hunt.claim.ID {
+			if waiting != "" {
+				s.println("   🤝 이제 이 작업이 조회를 맡아요")
+			}
+			return
+		}
+		if waiting != poller.ID {
+			s.printf("   🤝 %s 작업의 조회 결과를 기다려요 (빈자리가 보이면 %d번째로 예약을 시도해요)\n", poller.Job, seatRank+1)
+			waiting = poller.ID
+		}
+
+		if poll, ok := s.hunt.nextPoll(); ok && time.Since(poll.PolledAt) < huntSeatFresh {
+			if train, found := poll.seatTrain(); found {
+				s.printf("   🔔 %s 작업의 조회에서 %s열차 빈자리를 봤어요\n", poll.By, train.TrainNumber)
+				s.sleep(time.Duration(seatRank) * huntSeatStagger)
+				return
+			}
+		}
+		s.sleep(time.Second)
+	}
+}
+
+// 👀 감시 조회 함수 (같은 열차를 감시하는 작업이 있으면 한 작업만 사이트를 조회하고 나머지는 그 결과를 씀)
+func (s *session) pollShared(page playwright.Page, poll int) ([]trainAvailability, error) {
+	if s.hunt == nil {
+		return s.pollAvailability(page, poll)
+	}
+	deadline := time.Now().Add(3*time.Duration(retryConfig.watchInterval)*time.Second + huntStaleAfter)
+	for !s.finished() {
+		poller, _ := s.hunt.roles()
+		if poller.ID == s.hunt.claim.ID {
+			trains, err := s.pollAvailability(page, poll)
+			if err == nil {
+				s.shareTrains(trains)
+			}
+			return trains, err
+		}
+		// 끝난 실행이 남긴 오래된 조회 결과는 쓰지 않음
+		if shared, ok := s.hunt.nextPoll(); ok && time.Since(shared.PolledAt) < huntSeatFresh {
+			s.printf("   🤝 %s 작업의 조회 결과를 함께 써요 (%s 조회)\n", shared.By, shared.PolledAt.Format("15:04:05"))
+			return shared.trains(), nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("%s 작업의 조회 결과를 기다리다 시간이 지났어요", poller.Job)
+		}
+		s.sleep(time.Second)
+	}
+	return nil, context.Cause(s.ctx)
+}
+
+// 💾 JSON 파일을 임시 파일에 쓴 뒤 바꿔치기하는 함수 (다른 프로세스가 반쯤 쓴 파일을 읽지 않게)
+func writeJSONFile(path string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
@@ -0,0 +1,133 @@
+package lurker
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 📰 주기적 진행 요약 (digest)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 📰 요약 기간 동안 모은 통계 (요약을 보내면 초기화, 작업마다 따로)
+type huntStats struct {
+	sync.Mutex
+	periodStart   time.Time
+	attempts      int
+	outcomes      map[string]int               // 결과 분류 → 횟수
+	queueWaits    int                          // 대기열 진입 횟수
+	queueWaitTime time.Duration                // 대기열에서 기다린 총 시간
+	trainsSeen    map[string]trainAvailability // 열차번호 → 마지막으로 본 상태
+}
+
+// 📰 요약 알림에 담는 통계
+type digestSummary struct {
+	periodStart   time.Time
+	periodEnd     time.Time
+	attempts      int
+	outcomes      map[string]int
+	queueWaits    int
+	queueWaitTime time.Duration
+	trainsSeen    []trainAvailability
+}
+
+// 시도 결과를 요약용 분류로 나누는 함수
+func classifyAttemptError(err error) string {
+	message := err.Error()
+	switch {
+	case strings.Contains(message, "매진"):
+		return "매진"
+	case strings.Contains(message, "로그인"):
+		return "로그인 실패"
+	case strings.Contains(message, "Timeout") || strings.Contains(message, "timeout") || strings.Contains(message, "시간 초과"):
+		return "시간 초과"
+	case strings.Contains(message, "새로고침") || strings.Contains(message, "페이지 이동") || strings.Contains(message, "net::"):
+		return "페이지/네트워크 오류"
+	case strings.Contains(message, "찾을 수 없"):
+		return "열차/화면 요소 없음"
+	default:
+		return "기타 오류"
+	}
+}
+
+func (s *session) recordAttemptOutcome(err error, successLabel string) {
+	outcome := successLabel
+	if err != nil {
+		outcome = classifyAttemptError(err)
+	}
+	s.stats.Lock()
+	defer s.stats.Unlock()
+	s.stats.attempts++
+	s.stats.outcomes[outcome]++
+}
+
+func (s *session) recordQueueWait(waited time.Duration) {
+	s.stats.Lock()
+	defer s.stats.Unlock()
+	s.stats.queueWaits++
+	s.stats.queueWaitTime += waited
+}
+
+func (s *session) recordTrainSeen(train trainAvailability) {
+	if train.trainNumber == "" {
+		return
+	}
+	s.stats.Lock()
+	defer s.stats.Unlock()
+	s.stats.trainsSeen[train.trainNumber] = train
+}
+
+// 요약할 때가 되었는지 확인하고 통계를 꺼내 초기화하는 함수
+func (s *session) takeDigestIfDue() (digestSummary, bool) {
+	s.stats.Lock()
+	defer s.stats.Unlock()
+
+	due := (digestConfig.attempts > 0 && s.stats.attempts >= digestConfig.attempts) ||
+		(digestConfig.interval > 0 && time.Since(s.stats.periodStart) >= digestConfig.interval)
+	if !due {
+		return digestSummary{}, false
+	}
+
+	summary := digestSummary{
+		periodStart:   s.stats.periodStart,
+		periodEnd:     time.Now(),
+		attempts:      s.stats.attempts,
+		outcomes:      s.stats.outcomes,
+		queueWaits:    s.stats.queueWaits,
+		queueWaitTime: s.stats.queueWaitTime,
+	}
+	for _, train := range s.stats.trainsSeen {
+		summary.trainsSeen = append(summary.trainsSeen, train)
+	}
+	sort.Slice(summary.trainsSeen, func(i, j int) bool {
+		return summary.trainsSeen[i].deptText < summary.trainsSeen[j].deptText
+	})
+
+	s.stats.periodStart = summary.periodEnd
+	s.stats.attempts = 0
+	s.stats.outcomes = map[string]int{}
+	s.stats.queueWaits = 0
+	s.stats.queueWaitTime = 0
+	s.stats.trainsSeen = map[string]trainAvailability{}
+	return summary, true
+}
+
+// 📰 설정한 주기(N분 또는 N회)가 되면 진행 요약 알림을 보내는 함수
+func (s *session) maybeSendDigest() {
+	summary, due := s.takeDigestIfDue()
+	if !due {
+		return
+	}
+
+	s.control.Lock()
+	attempt := s.control.attempt
+	s.control.Unlock()
+
+	s.printf("   📰 진행 요약: 최근 %s 동안 %d회 시도\n",
+		summary.periodEnd.Sub(summary.periodStart).Round(time.Second), summary.attempts)
+	event := s.newNotificationEvent(eventDigest, attempt, "")
+	event.digest = summary
+	s.dispatchNotification(event)
+}
@@ -0,0 +1,173 @@
+package lurker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🩺 사이트 구조 점검 (doctor / 사전 점검)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🌐 Playwright와 Chromium을 띄우는 함수 (작업마다 브라우저 컨텍스트를 따로 열어 함께 써요)
+func launchBrowser(headless bool) (*playwright.Playwright, playwright.Browser, error) {
+	pw, err := playwright.Run()
+	if err != nil {
+		return nil, nil, fmt.Errorf("Playwright 실행 실패: %w", err)
+	}
+
+	browser, err := pw.Chromium.Launch(playwright.BrowserTypeLaunchOptions{
+		Headless: playwright.Bool(headless),
+		SlowMo:   playwright.Float(float64(browserConfig.slowMo.Milliseconds())),
+	})
+	if err != nil {
+		pw.Stop()
+		return nil, nil, fmt.Errorf("브라우저 실행 실패: %w", err)
+	}
+	return pw, browser, nil
+}
+
+// 🗂️ 작업 전용 브라우저 컨텍스트를 열고 조회 페이지로 이동하는 함수 (쿠키, 로그인 상태, trace가 작업마다 따로)
+func (s *session) openPage(browser playwright.Browser) (playwright.BrowserContext, playwright.Page, error) {
+	s.println("▶ 브라우저 초기화")
+	context, err := browser.NewContext()
+	if err != nil {
+		return nil, nil, fmt.Errorf("브라우저 컨텍스트 생성 실패: %w", err)
+	}
+
+	page, err := context.NewPage()
+	if err != nil {
+		context.Close()
+		return nil, nil, fmt.Errorf("페이지 생성 실패: %w", err)
+	}
+
+	s.recordDialogs(page)
+	s.startTracing(context)
+
+	if _, err := page.Goto(initialURL); err != nil {
+		context.Close()
+		return nil, nil, fmt.Errorf("페이지 이동 실패: %w", err)
+	}
+
+	s.println("   ✓ 브라우저 초기화 완료")
+	return context, page, nil
+}
+
+// 🩺 조회 페이지의 셀렉터와 조회 결과 표 구조가 프로필과 일치하는지 확인하는 함수
+// date가 비어 있으면 날짜 선택 목록의 첫 번째 날짜로 조회해요
+func (s *session) runHealthCheck(page playwright.Page, deptStation, arrivalStation, date string) error {
+	fprintSubHeader(s.out, "🩺 사이트 구조 점검")
+
+	var failures []string
+	check := func(name string, err error) bool {
+		if err != nil {
+			s.printf("   ✗ %s: %v\n", name, err)
+			failures = append(failures, fmt.Sprintf("%s: %v", name, err))
+			return false
+		}
+		s.printf("   ✓ %s\n", name)
+		return true
+	}
+	result := func() error {
+		if len(failures) == 0 {
+			s.println("   ✅ 모든 점검 항목을 통과했어요")
+			return nil
+		}
+		return fmt.Errorf("사이트 구조 점검 실패 (%d건): %s", len(failures), strings.Join(failures, "; "))
+	}
+
+	// 1. 조회 화면 요소
+	searchFormOK := true
+	for _, key := range []string{"dptStation", "arvStation", "date", "searchButton"} {
+		searchFormOK = check("selectors."+key, verifySelector(page, sel(key), key)) && searchFormOK
+	}
+	if !searchFormOK {
+		return result()
+	}
+
+	// 2. 실제 조회 수행
+	if date == "" {
+		firstDate, err := page.Locator(sel("date") + " option").First().GetAttribute("value")
+		if !check("조회 날짜 목록", err) {
+			return result()
+		}
+		date = firstDate
+	}
+	searchOK := check("출발역 입력", fillInput(page, sel("dptStation"), deptStation, "출발역")) &&
+		check("도착역 입력", fillInput(page, sel("arvStation"), arrivalStation, "도착역")) &&
+		check("날짜 선택", selectOption(page, sel("date"), date, "날짜")) &&
+		check("조회 버튼 클릭", clickButton(page, sel("searchButton"), "조회 버튼"))
+	if !searchOK {
+		return result()
+	}
+	s.waitForNetfunnel(page)
+
+	rowsErr := page.Locator(sel("trainRows")).First().WaitFor(playwright.LocatorWaitForOptions{
+		State:   playwright.WaitForSelectorStateAttached,
+		Timeout: playwright.Float(15000),
+	})
+	if !check("selectors.trainRows", rowsErr) {
+		return result()
+	}
+
+	// 3. 조회 결과 표의 열 구조
+	headers, err := page.Locator(sel("tableHeaders")).AllTextContents()
+	if err == nil && len(headers) == 0 {
+		err = fmt.Errorf("표 제목을 찾을 수 없어요 (selector: %s)", sel("tableHeaders"))
+	}
+	if check("selectors.tableHeaders", err) {
+		for _, key := range requiredColumnKeys {
+			index := col(key)
+			var columnErr error
+			if index >= len(headers) {
+				columnErr = fmt.Errorf("%d번째 열이 없어요 (표 제목 %d개)", index, len(headers))
+			} else if actual := strings.TrimSpace(headers[index]); !strings.Contains(actual, selectorRegistry.Headers[key]) {
+				columnErr = fmt.Errorf("%d번째 열 제목이 %q가 아니에요 (실제: %q)", index, selectorRegistry.Headers[key], actual)
+			}
+			check("columns."+key, columnErr)
+		}
+	}
+
+	tds, err := page.Locator(sel("trainRows")).First().Locator("td").All()
+	if err == nil && len(tds) < minColumnCount() {
+		err = fmt.Errorf("열이 %d개뿐이에요 (최소 %d개 필요)", len(tds), minColumnCount())
+	}
+	if check("조회 결과 행 구조", err) {
+		_, err := tds[col("departure")].Locator("em").TextContent(playwright.LocatorTextContentOptions{
+			Timeout: playwright.Float(3000),
+		})
+		check("출발 시간 표시 (columns.departure > em)", err)
+	}
+
+	s.println("   ℹ️ 예약/로그인/예약자 정보 화면 요소는 실제 예약 과정에서 확인돼요")
+	return result()
+}
+
+// 🩺 doctor 명령: 기본 노선으로 사이트 구조를 점검하고 종료 코드를 돌려주는 함수
+func runDoctor() int {
+	printHeader("🩺 SRT 사이트 구조 점검 (doctor)")
+
+	pw, browser, err := launchBrowser(true)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitFailure
+	}
+	defer pw.Stop()
+	defer browser.Close()
+
+	s := newSession(context.Background(), Job{Mode: "watch", From: "수서", To: "부산"}, os.Stdout)
+	_, page, err := s.openPage(browser)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitFailure
+	}
+	if err := s.runHealthCheck(page, s.job.From, s.job.To, ""); err != nil {
+		fmt.Printf("\n❌ %v\n", err)
+		return exitFailure
+	}
+	return exitOK
+}
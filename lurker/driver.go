@@ -0,0 +1,545 @@
+package lurker
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 📋 상수 정의
+// ═══════════════════════════════════════════════════════════════════════════════
+
+const initialURL = "https://etk.srail.kr/hpg/hra/01/selectScheduleList.do?pageId=TK0101010000"
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🤖 웹 자동화 헬퍼 함수들
+// ═══════════════════════════════════════════════════════════════════════════════
+
+func fillInput(page playwright.Page, selector, value, fieldName string) error {
+	input := page.Locator(selector)
+
+	if err := safeAction(func() error { return input.Click() }, fieldName+" 클릭 실패"); err != nil {
+		return err
+	}
+	if err := safeAction(func() error { return input.Fill("") }, fieldName+" 입력 비우기 실패"); err != nil {
+		return err
+	}
+	if err := safeAction(func() error { return input.Fill(value) }, fieldName+" 입력 실패"); err != nil {
+		return err
+	}
+	if err := safeAction(func() error { return input.Press("Tab") }, fieldName+" 확정 실패"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func selectOption(page playwright.Page, selector, value, fieldName string) error {
+	_, err := page.Locator(selector).SelectOption(playwright.SelectOptionValues{
+		Values: playwright.StringSlice(value),
+	})
+	return safeAction(func() error { return err }, fieldName+" 선택 실패")
+}
+
+func clickButton(page playwright.Page, selector, buttonName string) error {
+	return safeAction(func() error {
+		return page.Locator(selector).Click()
+	}, buttonName+" 클릭 실패")
+}
+
+// 🔎 요소가 페이지에 존재하는지만 확인하는 함수 (클릭하지 않음)
+func verifySelector(page playwright.Page, selector, elementName string) error {
+	count, err := page.Locator(selector).Count()
+	if err != nil {
+		return fmt.Errorf("%s 확인 실패: %w", elementName, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%s를 찾을 수 없어요 (selector: %s)", elementName, selector)
+	}
+	return nil
+}
+
+// 🔎 방금 입력한 값이 포커스된 입력 칸에 들어갔는지 확인하는 함수 (비밀번호도 있어서 값은 출력하지 않음)
+func verifyFocusedValue(page playwright.Page, want, fieldName string) error {
+	value, err := page.Evaluate(`() => document.activeElement && document.activeElement.value`)
+	if err != nil {
+		return fmt.Errorf("%s 입력값 확인 실패: %w", fieldName, err)
+	}
+	if got, _ := value.(string); got != want {
+		return fmt.Errorf("%s 칸에 입력값이 들어가지 않았어요", fieldName)
+	}
+	return nil
+}
+
+func (s *session) setupDialogHandler(page playwright.Page, acceptDialog bool) {
+	page.OnDialog(func(dialog playwright.Dialog) {
+		s.printf("   > 대화상자 감지: %s\n", dialog.Message())
+		if acceptDialog {
+			s.println("   > 자동으로 '확인' 클릭")
+			dialog.Accept()
+		} else {
+			s.println("   > 자동으로 '취소' 클릭")
+			dialog.Dismiss()
+		}
+	})
+}
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🚀 자동화 단계별 처리 함수들
+// ═══════════════════════════════════════════════════════════════════════════════
+
+func (s *session) step1SetStations(page playwright.Page) error {
+	s.println("🚉 1단계: 출발역/도착역 설정")
+
+	s.printf("   > 출발역: %s\n", s.job.From)
+	if err := fillInput(page, sel("dptStation"), s.job.From, "출발역"); err != nil {
+		return err
+	}
+
+	s.printf("   > 도착역: %s\n", s.job.To)
+	if err := fillInput(page, sel("arvStation"), s.job.To, "도착역"); err != nil {
+		return err
+	}
+
+	s.println("   ✓ 출발역/도착역 설정 완료")
+	return nil
+}
+
+func (s *session) step2SetDate(page playwright.Page) error {
+	s.println("📅 2단계: 출발 날짜 설정")
+	if err := selectOption(page, sel("date"), s.job.Date, "날짜"); err != nil {
+		return err
+	}
+	s.println("   ✓ 출발 날짜 설정 완료")
+	return nil
+}
+
+func (s *session) step3SearchTrains(page playwright.Page) error {
+	s.println("🔍 3단계: 열차 조회")
+	if err := clickButton(page, sel("searchButton"), "조회 버튼"); err != nil {
+		return err
+	}
+
+	// 로딩 애니메이션과 함께 대기
+	s.showLoadingAnimation("열차 정보를 조회하는 중이에요", 3)
+
+	return nil
+}
+
+// ⏳ NetFunnel 대기열이 떠 있으면 통과할 때까지 기다리는 함수
+func (s *session) waitForNetfunnel(page playwright.Page) {
+	netfunnelLocator := page.Locator(sel("netfunnel"))
+	if count, _ := netfunnelLocator.Count(); count > 0 {
+		s.println("   ⏳ 대기열에 진입했어요")
+		enteredAt := time.Now()
+		defer func() { s.recordQueueWait(time.Since(enteredAt)) }()
+
+		// 대기열 진입 애니메이션
+		done := make(chan bool)
+		go func() {
+			// 더 예쁜 대기열 스피너 (회전하는 점들)
+			spinner := []string{"🔄", "🔃", "🔄", "🔃"}
+			i := 0
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					s.printf("\r   %s 대기열에서 순서를 기다리는 중이에요 (최대 1분)", spinner[i%len(spinner)])
+					i++
+					time.Sleep(250 * time.Millisecond) // 더 빠른 속도 (500ms → 250ms)
+				}
+			}
+		}()
+
+		netfunnelLocator.WaitFor(playwright.LocatorWaitForOptions{
+			State:   playwright.WaitForSelectorStateHidden,
+			Timeout: playwright.Float(1000 * 60),
+		})
+
+		done <- true
+		s.printf("\r   ✅ 대기열 통과 완료!                                    \n")
+	}
+}
+
+func (s *session) step4CheckAvailability(page playwright.Page) error {
+	s.println("📋 4단계: 예약 가능 열차 확인")
+
+	s.waitForNetfunnel(page)
+
+	// 열차 정보 확인 중 애니메이션
+	s.showLoadingAnimation("예약 가능한 열차를 확인하는 중이에요", 1)
+
+	trs, err := page.Locator(sel("trainRows")).All()
+	if err != nil {
+		return err
+	}
+
+	for _, tr := range trs {
+		tds, err := tr.Locator("td").All()
+		if err != nil {
+			return err
+		}
+
+		if len(tds) < minColumnCount() {
+			continue
+		}
+
+		dept, err := tds[col("departure")].Locator("em").TextContent()
+		if err != nil {
+			continue
+		}
+		arrival, err := tds[col("arrival")].Locator("em").TextContent()
+		if err != nil {
+			continue
+		}
+
+		s.println(dept, arrival)
+
+		if strings.Contains(dept, s.job.DeptTime) && strings.Contains(arrival, s.job.ArrivalTime) {
+			// 5단계 전에 실패하거나 다시 시도해도 같은 열차를 감시하는 작업이 조회 결과를 받을 수 있도록 바로 공유
+			trainNumber, _ := tds[col("trainNumber")].TextContent()
+			seen := trainAvailability{
+				trainNumber: strings.TrimSpace(trainNumber),
+				deptText:    strings.TrimSpace(dept),
+				arrivalText: strings.TrimSpace(arrival),
+				premium:     seatStatus(tds[col("premium")]),
+				standard:    seatStatus(tds[col("standard")]),
+			}
+			s.recordTrainSeen(seen)
+			s.shareTrains([]trainAvailability{seen})
+			s.println("   ✓ 예약 가능한 열차 발견")
+			return nil
+		}
+	}
+
+	return fmt.Errorf("예약 가능한 열차를 찾을 수 없어요")
+}
+
+func (s *session) step5ClickReserve(page playwright.Page) error {
+	s.println("🎯 5단계: 예약 시도")
+
+	trs, err := page.Locator(sel("trainRows")).All()
+	if err != nil {
+		return err
+	}
+
+	for _, tr := range trs {
+		tds, err := tr.Locator("td").All()
+		if err != nil {
+			continue
+		}
+
+		if len(tds) < minColumnCount() {
+			continue
+		}
+
+		dept, err := tds[col("departure")].Locator("em").TextContent()
+		if err != nil {
+			continue
+		}
+		arrival, err := tds[col("arrival")].Locator("em").TextContent()
+		if err != nil {
+			continue
+		}
+
+		if strings.Contains(dept, s.job.DeptTime) && strings.Contains(arrival, s.job.ArrivalTime) {
+			fullText, err := tds[col("standard")].Locator(sel("soldOut")).Count()
+			if err != nil {
+				continue
+			}
+			trainNumber, _ := tds[col("trainNumber")].TextContent()
+			seen := trainAvailability{
+				trainNumber: strings.TrimSpace(trainNumber),
+				deptText:    strings.TrimSpace(dept),
+				arrivalText: strings.TrimSpace(arrival),
+				premium:     seatStatus(tds[col("premium")]),
+				standard:    seatStatus(tds[col("standard")]),
+			}
+			s.recordTrainSeen(seen)
+			s.shareTrains([]trainAvailability{seen})
+			if fullText > 0 {
+				return fmt.Errorf("매진된 열차에요 - 예매를 다시 시도해요")
+			}
+
+			reserveButton := tds[col("standard")].Locator(sel("reserveButton"))
+			if err := reserveButton.Click(); err != nil {
+				continue
+			}
+
+			s.selectedTrain = trainAvailability{
+				trainNumber: strings.TrimSpace(trainNumber),
+				deptText:    strings.TrimSpace(dept),
+				arrivalText: strings.TrimSpace(arrival),
+				premium:     seatStatus(tds[col("premium")]),
+				standard:    "예약가능",
+			}
+			s.println("   ✓ 예약하기 버튼 클릭 완료")
+			return nil
+		}
+	}
+
+	return fmt.Errorf("예약하기 버튼을 찾을 수 없어요")
+}
+
+func (s *session) step6ChooseReservationType(page playwright.Page) error {
+	s.showLoadingAnimation("예매 페이지로 이동하는 중이에요", 1)
+	s.println("🛂 6단계: 예매 경로 선택")
+
+	s.setupDialogHandler(page, true)
+
+	// 미등록 고객인 경우 미등록고객 예매 버튼 클릭
+	if s.job.CustomerType == "unregistered" {
+		s.println("   > 미등록 고객 예매 선택")
+		if err := clickButton(page, sel("unregisteredReserveButton"), "미등록고객 예매 버튼"); err != nil {
+			return err
+		}
+		s.println("   ✓ 미등록고객 예매 버튼 클릭 및 대화상자 처리 완료")
+	}
+
+	// 로그인 고객인 경우 스킵
+
+	return nil
+}
+
+func (s *session) step7LoginProcess(page playwright.Page) error {
+	if s.job.CustomerType == "unregistered" {
+		// 미등록 고객인 경우 미등록고객 예매 버튼 클릭
+		currentURL := page.URL()
+		if !strings.Contains(currentURL, "selectReservationForm") {
+			return fmt.Errorf("예약 페이지로 이동하지 못했어요 (현재 URL: %s)", currentURL)
+		}
+		s.println("   ✓ 예약자 정보 입력 화면으로 이동 완료")
+		return nil
+	} else {
+		// 로그인 고객인 경우 실제 로그인 처리
+		return s.step7ProcessLogin(page)
+	}
+}
+
+func (s *session) step7ProcessLogin(page playwright.Page) error {
+	s.println("▶ 7단계: 로그인 처리")
+
+	// 로그인 타입에 따른 라디오 버튼 선택 및 입력 필드 selector 조회
+	var keySuffix string
+	switch s.job.LoginType {
+	case "member":
+		keySuffix = "Member"
+		s.println("   > 회원번호 로그인 선택")
+	case "email":
+		keySuffix = "Email"
+		s.println("   > 이메일 로그인 선택")
+	case "phone":
+		keySuffix = "Phone"
+		s.println("   > 전화번호 로그인 선택")
+	default:
+		return fmt.Errorf("알 수 없는 로그인 타입: %s", s.job.LoginType)
+	}
+
+	loginTypeSelector := sel("loginType" + keySuffix)
+	loginIdSelector := sel("loginId" + keySuffix)
+	loginPasswordSelector := sel("loginPassword" + keySuffix)
+	loginSubmitSelector := sel("loginSubmit" + keySuffix)
+
+	// 로그인 타입 라디오 버튼 클릭
+	if err := clickButton(page, loginTypeSelector, "로그인 타입"); err != nil {
+		return fmt.Errorf("로그인 타입 선택 실패: %w", err)
+	}
+
+	s.showLoadingAnimation("로그인 폼을 준비하는 중이에요", 1)
+
+	// 로그인 ID 입력
+	s.printf("   > 로그인 ID 입력: %s\n", s.job.LoginID)
+	if err := fillInput(page, loginIdSelector, s.job.LoginID, "로그인 ID"); err != nil {
+		return fmt.Errorf("로그인 ID 입력 실패: %w", err)
+	}
+
+	// 로그인 비밀번호 입력
+	s.printf("   > 로그인 비밀번호 입력: %s\n", strings.Repeat("*", len(s.job.LoginPassword)))
+	if err := fillInput(page, loginPasswordSelector, s.job.LoginPassword, "로그인 비밀번호"); err != nil {
+		return fmt.Errorf("로그인 비밀번호 입력 실패: %w", err)
+	}
+
+	// 드라이런: 로그인 제출(= 로그인 고객 예약 확정)은 하지 않고 버튼 존재만 확인
+	if s.dryRun {
+		if err := verifySelector(page, loginSubmitSelector, "로그인 제출 버튼"); err != nil {
+			return err
+		}
+		s.println("   🧪 드라이런: 로그인 버튼은 누르지 않고 여기서 멈춰요")
+		return nil
+	}
+
+	// 로그인 버튼 클릭
+	s.println("   > 로그인 버튼 클릭")
+	if err := clickButton(page, loginSubmitSelector, "로그인 제출"); err != nil {
+		return fmt.Errorf("로그인 버튼 클릭 실패: %w", err)
+	}
+
+	s.showLoadingAnimation("로그인 처리 중이에요", 3)
+
+	// 로그인 성공 확인 (URL이나 특정 요소로 확인 가능)
+	currentURL := page.URL()
+	if strings.Contains(currentURL, "login") {
+		return fmt.Errorf("로그인에 실패했어요. 아이디나 비밀번호를 확인해주세요")
+	}
+
+	// '나중에 변경하기' 링크가 있으면 클릭
+	laterChangeLink := page.Locator(sel("laterChangeLink"))
+	if count, _ := laterChangeLink.Count(); count > 0 {
+		s.println("   > '나중에 변경하기' 링크 발견, 클릭해요...")
+		if err := laterChangeLink.Click(); err != nil {
+			s.printf("   ⚠️ '나중에 변경하기' 링크 클릭 실패 (계속 진행): %v\n", err)
+		} else {
+			s.println("   ✓ '나중에 변경하기' 링크 클릭 완료")
+			s.showLoadingAnimation("페이지 이동을 기다리는 중이에요", 2)
+		}
+	}
+
+	s.println("   ✓ 로그인 완료")
+
+	// 로그인 고객은 여기서 예약이 완료됨
+	s.println("🎉 로그인 고객 예약이 완료되었어요!")
+
+	return nil
+}
+
+func (s *session) step8FillPassengerInfoUnregistered(page playwright.Page) error {
+	s.println("▶ 8단계: 예약자 정보 입력 (미등록 고객)")
+
+	if err := clickButton(page, sel("passengerAgree"), "개인정보수집 동의 체크박스"); err != nil {
+		return err
+	}
+
+	if err := fillInput(page, sel("passengerName"), s.job.Name, "예약자 이름"); err != nil {
+		return err
+	}
+
+	inputValues := []struct {
+		value string
+		desc  string
+	}{
+		{s.job.Phone[:3], "전화번호 앞자리"},
+		{s.job.Phone[3:7], "전화번호 중간자리"},
+		{s.job.Phone[7:], "전화번호 뒷자리"},
+		{s.job.Password, "비밀번호"},
+		{s.job.Password, "비밀번호 확인"},
+	}
+
+	for _, input := range inputValues {
+		if err := page.Keyboard().Type(input.value); err != nil {
+			return fmt.Errorf("%s 입력 실패: %w", input.desc, err)
+		}
+		// 드라이런: Tab으로 옮겨 가며 입력하는 칸은 셀렉터가 없어서 포커스된 칸의 값으로 확인
+		if s.dryRun {
+			if err := verifyFocusedValue(page, input.value, input.desc); err != nil {
+				return err
+			}
+		}
+		s.printf("   ✓ %s 입력 완료\n", input.desc)
+
+		if err := page.Keyboard().Press("Tab"); err != nil {
+			return fmt.Errorf("%s 입력 후 Tab 이동 실패: %w", input.desc, err)
+		}
+	}
+
+	s.println("   ✓ 예약자 정보 입력 완료")
+
+	// 드라이런: 동의 체크와 입력값이 실제로 폼에 들어갔는지만 확인하고 예약 확정은 하지 않음
+	// (전화번호와 비밀번호는 위에서 한 칸씩 확인)
+	if s.dryRun {
+		agreed, err := page.Locator(sel("passengerAgree")).IsChecked()
+		if err != nil {
+			return fmt.Errorf("개인정보수집 동의 확인 실패: %w", err)
+		}
+		if !agreed {
+			return fmt.Errorf("개인정보수집 동의가 체크되지 않았어요")
+		}
+		filledName, err := page.Locator(sel("passengerName")).InputValue()
+		if err != nil {
+			return fmt.Errorf("예약자 이름 입력값 확인 실패: %w", err)
+		}
+		if filledName != s.job.Name {
+			return fmt.Errorf("예약자 이름이 폼에 입력되지 않았어요 (입력값: %q)", filledName)
+		}
+		s.println("   🧪 드라이런: 예약 확정 Enter는 누르지 않고 여기서 멈춰요")
+		return nil
+	}
+
+	// 예약 확정 (Tab + Enter)
+	s.println("   > 예약 확정 버튼으로 이동 및 클릭")
+
+	if err := page.Keyboard().Press("Enter"); err != nil {
+		return fmt.Errorf("예약 확정 Enter 키 입력 실패: %w", err)
+	}
+
+	s.showLoadingAnimation("예약을 처리하는 중이에요", 3)
+
+	s.println("🎉 미등록 고객 예약이 완료되었어요!")
+
+	return nil
+}
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🔄 예약 시도 메인 함수
+// ═══════════════════════════════════════════════════════════════════════════════
+
+func (s *session) attemptReservation(page playwright.Page, attempt int) error {
+	s.printf("\n↻ 시도 %d/%d 시작...\n", attempt, retryConfig.maxAttempts)
+	s.println(strings.Repeat("=", 50))
+
+	if attempt > 1 {
+		s.println("⟳ 페이지 새로고침...")
+		if _, err := page.Reload(); err != nil {
+			return fmt.Errorf("페이지 새로고침 실패: %w", err)
+		}
+		s.showLoadingAnimation("페이지를 새로고침하고 있어요", 3)
+	}
+
+	steps := []func(playwright.Page) error{
+		s.step1SetStations,
+		s.step2SetDate,
+		s.step3SearchTrains,
+		s.step4CheckAvailability,
+		s.step5ClickReserve,
+		s.step6ChooseReservationType,
+		s.step7LoginProcess,
+	}
+
+	// 미등록 고객만 step8 (예약자 정보 입력) 필요
+	if s.job.CustomerType == "unregistered" {
+		steps = append(steps, s.step8FillPassengerInfoUnregistered)
+	}
+
+	for i, step := range steps {
+		if i == 4 {
+			s.showLoadingAnimation("예약 시도를 준비하는 중이에요", 3)
+		}
+		if err := step(page); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// 🧪 드라이런 결과 보고 함수
+func (s *session) printDryRunReport(attempt int) {
+	fprintHeader(s.out, "🧪 드라이런 결과")
+	s.printf("   ✓ %d번째 시도에서 모든 단계의 요소를 찾고 폼 입력까지 완료했어요\n", attempt)
+	s.println("   ℹ️ 예약하기 버튼은 실제로 눌렀지만 최종 예약 확정은 하지 않았어요. 실제 실행 시 아래 내용으로 예약돼요")
+	s.println()
+	s.printf("    열차번호: %s\n", s.selectedTrain.trainNumber)
+	s.printf("    출발역: %s (%s)\n", s.job.From, s.selectedTrain.deptText)
+	s.printf("    도착역: %s (%s)\n", s.job.To, s.selectedTrain.arrivalText)
+	s.printf("    날짜: %s\n", s.job.Date)
+	s.println("    좌석: 일반실 (자동 배정)")
+	if s.job.CustomerType == "unregistered" {
+		s.printf("    예약자: %s (미등록 고객)\n", s.job.Name)
+	} else {
+		s.printf("    예약자: 로그인 고객 (%s)\n", s.job.LoginID)
+	}
+	s.println()
+}
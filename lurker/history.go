@@ -0,0 +1,144 @@
+package lurker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🗒️ 실행 기록 (history)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🗒️ 실행 한 번의 기록 (상태 폴더/history.jsonl에 한 줄씩, 개인정보는 저장하지 않음)
+type runHistoryEntry struct {
+	Job               string    `json:"job,omitempty"` // 작업 이름 (jobs 명령으로 실행했을 때)
+	StartedAt         time.Time `json:"startedAt"`
+	EndedAt           time.Time `json:"endedAt"`
+	Mode              string    `json:"mode"`
+	From              string    `json:"from"`
+	To                string    `json:"to"`
+	Date              string    `json:"date"`
+	DeptTime          string    `json:"deptTime"`
+	ArrivalTime       string    `json:"arrivalTime"`
+	Result            string    `json:"result"` // reserved, dry-run, failed, stopped, interrupted, preflight-failed
+	Attempts          int       `json:"attempts"`
+	Train             string    `json:"train,omitempty"`
+	ReservationNumber string    `json:"reservationNumber,omitempty"`
+	LastError         string    `json:"lastError,omitempty"`
+}
+
+func historyPath() string {
+	return filepath.Join(stateDir(), "history.jsonl")
+}
+
+// 📝 실행 결과를 기록에 추가하는 함수 (실패해도 실행은 계속)
+func (s *session) recordRunHistory(result string, attempts int, lastError error) {
+	entry := runHistoryEntry{
+		Job:         s.job.ID,
+		StartedAt:   s.control.startedAt,
+		EndedAt:     time.Now(),
+		Mode:        s.job.Mode,
+		From:        s.job.From,
+		To:          s.job.To,
+		Date:        s.job.Date,
+		DeptTime:    s.job.DeptTime,
+		ArrivalTime: s.job.ArrivalTime,
+		Result:      result,
+		Attempts:    attempts,
+	}
+	if result == "reserved" || result == "dry-run" {
+		entry.Train = s.selectedTrain.trainNumber
+		entry.ReservationNumber = s.confirmedReservation.reservationNumber
+	}
+	if lastError != nil {
+		entry.LastError = redact(lastError.Error())
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	file, err := os.OpenFile(historyPath(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		s.printf("⚠️ 실행 기록을 저장하지 못했어요: %v\n", err)
+		return
+	}
+	defer file.Close()
+	file.Write(append(line, '\n'))
+}
+
+// 📖 실행 기록을 읽는 함수 (깨진 줄은 건너뜀)
+func loadRunHistory() ([]runHistoryEntry, error) {
+	data, err := os.ReadFile(historyPath())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("실행 기록을 읽을 수 없어요: %w", err)
+	}
+
+	var entries []runHistoryEntry
+	for _, line := range strings.Split(string(data), "\n") {
+		var entry runHistoryEntry
+		if strings.TrimSpace(line) == "" || json.Unmarshal([]byte(line), &entry) != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// 🗒️ history 명령 (최근 실행 기록을 최신순으로 출력)
+func runHistoryCommand(limit int) int {
+	entries, err := loadRunHistory()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitFailure
+	}
+	if len(entries) == 0 {
+		fmt.Println("ℹ️ 아직 실행 기록이 없어요")
+		return exitOK
+	}
+
+	resultText := map[string]string{
+		"reserved":         "✅ 예약 성공",
+		"dry-run":          "🧪 드라이런",
+		"failed":           "❌ 모든 시도 실패",
+		"stopped":          "⛔ 원격 중지",
+		"interrupted":      "⛔ 사용자 중단",
+		"preflight-failed": "🩺 사전 점검 실패",
+	}
+	modeText := map[string]string{"reserve": "자동 예약", "watch": "빈자리 감시"}
+
+	fmt.Printf("🗒️ 최근 실행 기록 (%s)\n", historyPath())
+	shown := 0
+	for i := len(entries) - 1; i >= 0 && (limit <= 0 || shown < limit); i-- {
+		entry := entries[i]
+		shown++
+		fmt.Printf("\n%s  %s  %s", entry.StartedAt.Format("2006-01-02 15:04"),
+			firstNonEmpty(modeText[entry.Mode], entry.Mode), firstNonEmpty(resultText[entry.Result], entry.Result))
+		if entry.Job != "" {
+			fmt.Printf("  [%s]", entry.Job)
+		}
+		fmt.Println()
+		fmt.Printf("   %s(%s) → %s(%s), %s\n", entry.From, entry.DeptTime, entry.To, entry.ArrivalTime, entry.Date)
+		fmt.Printf("   시도 %d회, 소요 %s\n", entry.Attempts, entry.EndedAt.Sub(entry.StartedAt).Round(time.Second))
+		if entry.Train != "" {
+			fmt.Printf("   열차 %s", entry.Train)
+			if entry.ReservationNumber != "" {
+				fmt.Printf(", 예약번호 %s", entry.ReservationNumber)
+			}
+			fmt.Println()
+		}
+		if entry.LastError != "" {
+			fmt.Printf("   마지막 오류: %s\n", entry.LastError)
+		}
+	}
+	return exitOK
+}
@@ -0,0 +1,362 @@
+package lurker
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 📋 대화형 메뉴 관련 함수들
+// ═══════════════════════════════════════════════════════════════════════════════
+
+func selectFromMenu(title string, items []string) string {
+	itemsPerPage := 10
+	currentPage := 0
+	totalPages := (len(items) + itemsPerPage - 1) / itemsPerPage
+
+	for {
+		start := currentPage * itemsPerPage
+		end := start + itemsPerPage
+		if end > len(items) {
+			end = len(items)
+		}
+
+		fmt.Print("\033[2J\033[H")
+		fmt.Printf("🚄 %s\n", title)
+		fmt.Println(strings.Repeat("=", 50))
+		fmt.Printf("페이지 %d/%d (총 %d개 역)\n", currentPage+1, totalPages, len(items))
+		fmt.Println()
+
+		for i := start; i < end; i++ {
+			fmt.Printf("  %d. %s\n", i-start+1, items[i])
+		}
+
+		fmt.Println()
+		fmt.Println("📋 선택 방법:")
+		fmt.Println("  1-10: 번호로 역 선택")
+		if currentPage > 0 {
+			fmt.Println("  p: 이전 페이지")
+		}
+		if currentPage < totalPages-1 {
+			fmt.Println("  n: 다음 페이지")
+		}
+		fmt.Println("  q: 프로그램 종료")
+		fmt.Print("\n선택해주세요: ")
+
+		reader := bufio.NewReader(os.Stdin)
+		input, _ := reader.ReadString('\n')
+		input = strings.TrimSpace(strings.ToLower(input))
+
+		switch input {
+		case "q":
+			fmt.Println("프로그램을 종료할게요")
+			os.Exit(0)
+		case "n":
+			if currentPage < totalPages-1 {
+				currentPage++
+			}
+		case "p":
+			if currentPage > 0 {
+				currentPage--
+			}
+		default:
+			if num, err := strconv.Atoi(input); err == nil {
+				if num >= 1 && num <= end-start {
+					selectedIndex := start + num - 1
+					fmt.Print("\033[2J\033[H")
+					return items[selectedIndex]
+				}
+			}
+			fmt.Printf("❌ 잘못된 입력이에요. 1-%d 또는 n/p/q를 입력해주세요\n", end-start)
+			fmt.Print("아무 키나 눌러서 계속...")
+			reader.ReadString('\n')
+		}
+	}
+}
+
+func selectStation(title string) string {
+	return selectFromMenu(title, srtStations)
+}
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 💬 사용자 입력 및 인터페이스 함수들
+// ═══════════════════════════════════════════════════════════════════════════════
+
+func printHeader(title string) {
+	fprintHeader(os.Stdout, title)
+}
+
+func printSubHeader(title string) {
+	fprintSubHeader(os.Stdout, title)
+}
+
+func fprintHeader(w io.Writer, title string) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "🚄 "+strings.Repeat("=", 50))
+	fmt.Fprintf(w, "   %s\n", title)
+	fmt.Fprintln(w, "   "+strings.Repeat("=", 50))
+	fmt.Fprintln(w)
+}
+
+func fprintSubHeader(w io.Writer, title string) {
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "📋 %s\n", title)
+	fmt.Fprintln(w, "   "+strings.Repeat("-", 30))
+}
+
+func getUserInput(prompt, defaultValue string, examples ...string) string {
+	reader := bufio.NewReader(os.Stdin)
+
+	if defaultValue != "" {
+		fmt.Printf("   %s [기본값: %s]: ", prompt, defaultValue)
+	} else {
+		fmt.Printf("   %s: ", prompt)
+	}
+
+	if len(examples) > 0 && examples[0] != "" {
+		fmt.Printf("\n   💡 예시: %s\n   입력: ", examples[0])
+	}
+
+	input, _ := reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+
+	if input == "" && defaultValue != "" {
+		return defaultValue
+	}
+
+	return input
+}
+
+// 🔐 비밀번호 입력 전용 함수 (화면에 표시되지 않음)
+func getPasswordInput(prompt string) string {
+	fmt.Printf("   %s: ", prompt)
+
+	// 터미널을 raw 모드로 설정
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		// raw 모드 설정 실패 시 일반 입력으로 fallback
+		fmt.Println("(보안 입력 모드 실패, 일반 입력으로 진행)")
+		reader := bufio.NewReader(os.Stdin)
+		input, _ := reader.ReadString('\n')
+		return strings.TrimSpace(input)
+	}
+	defer term.Restore(fd, oldState)
+
+	var password []byte
+	for {
+		char := make([]byte, 1)
+		_, err := os.Stdin.Read(char)
+		if err != nil {
+			break
+		}
+
+		// Enter 키 (13 또는 10)
+		if char[0] == 13 || char[0] == 10 {
+			fmt.Println() // 줄바꿈
+			break
+		}
+
+		// Backspace 키 (127 또는 8)
+		if char[0] == 127 || char[0] == 8 {
+			if len(password) > 0 {
+				password = password[:len(password)-1]
+			}
+			continue
+		}
+
+		// Ctrl+C (3)
+		if char[0] == 3 {
+			fmt.Println()
+			os.Exit(0)
+		}
+
+		// 일반 문자만 추가
+		if char[0] >= 32 && char[0] <= 126 {
+			password = append(password, char[0])
+		}
+	}
+
+	return string(password)
+}
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// ✅ 입력 검증 함수들
+// ═══════════════════════════════════════════════════════════════════════════════
+
+func validateRequired(value, fieldName string) bool {
+	if strings.TrimSpace(value) == "" {
+		fmt.Printf("   ❌ %s는 필수 입력 항목이에요.\n\n", fieldName)
+		return false
+	}
+	return true
+}
+
+func validatePhone(phone string) bool {
+	re := regexp.MustCompile(`^010\d{8}$`)
+	if !re.MatchString(phone) {
+		fmt.Println("   ❌ 전화번호는 010으로 시작하는 11자리 숫자여야 해요")
+		fmt.Println("   💡 예시: 01012345678")
+		fmt.Println()
+		return false
+	}
+	return true
+}
+
+func validateTime(timeStr string) bool {
+	if !validateRequired(timeStr, "시간") {
+		return false
+	}
+
+	// 4자리 숫자인지 확인
+	if len(timeStr) != 4 {
+		fmt.Println("   ❌ 시간은 4자리 숫자로 입력해주세요")
+		fmt.Println("   💡 예시: 1037 (10시 37분), 0622 (06시 22분)")
+		fmt.Println()
+		return false
+	}
+
+	// 숫자인지 확인
+	if _, err := strconv.Atoi(timeStr); err != nil {
+		fmt.Println("   ❌ 시간은 숫자만 입력 가능해요")
+		fmt.Println("   💡 예시: 1037 (10시 37분), 0622 (06시 22분)")
+		fmt.Println()
+		return false
+	}
+
+	// 시간과 분 추출
+	hour, _ := strconv.Atoi(timeStr[:2])
+	minute, _ := strconv.Atoi(timeStr[2:])
+
+	// 시간 범위 확인 (00~23)
+	if hour < 0 || hour > 23 {
+		fmt.Println("   ❌ 시간은 00~23 사이여야 해요")
+		fmt.Println("   💡 예시: 1037 (10시 37분), 0622 (06시 22분)")
+		fmt.Println()
+		return false
+	}
+
+	// 분 범위 확인 (00~59)
+	if minute < 0 || minute > 59 {
+		fmt.Println("   ❌ 분은 00~59 사이여야 해요")
+		fmt.Println("   💡 예시: 1037 (10시 37분), 0622 (06시 22분)")
+		fmt.Println()
+		return false
+	}
+
+	return true
+}
+
+func validateMonth(monthStr string) bool {
+	if !validateRequired(monthStr, "출발 월") {
+		return false
+	}
+
+	month, err := strconv.Atoi(monthStr)
+	if err != nil {
+		fmt.Println("   ❌ 월은 숫자로 입력해주세요")
+		fmt.Println()
+		return false
+	}
+
+	if month < 1 || month > 12 {
+		fmt.Println("   ❌ 월은 1~12 사이의 숫자여야 해요")
+		fmt.Println("   💡 예시: 6")
+		fmt.Println()
+		return false
+	}
+
+	return true
+}
+
+func validateDay(dayStr string) bool {
+	if !validateRequired(dayStr, "출발 일") {
+		return false
+	}
+
+	day, err := strconv.Atoi(dayStr)
+	if err != nil {
+		fmt.Println("   ❌ 일은 숫자로 입력해주세요")
+		fmt.Println()
+		return false
+	}
+
+	if day < 1 || day > 31 {
+		fmt.Println("   ❌ 일은 1~31 사이의 숫자여야 해요")
+		fmt.Println("   💡 예시: 22")
+		fmt.Println()
+		return false
+	}
+
+	return true
+}
+
+func validateDate(dateStr string) bool {
+	re := regexp.MustCompile(`^\d{8}$`)
+	if !re.MatchString(dateStr) {
+		fmt.Println("   ❌ 날짜는 YYYYMMDD 형식으로 입력해주세요")
+		fmt.Println("   💡 예시: 20250622")
+		fmt.Println()
+		return false
+	}
+
+	if _, err := time.Parse("20060102", dateStr); err != nil {
+		fmt.Println("   ❌ 유효하지 않은 날짜에요")
+		fmt.Println()
+		return false
+	}
+
+	return true
+}
+
+var emailFormatPattern = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+func validateEmail(email string) bool {
+	if email == "" {
+		return true
+	}
+
+	if !emailFormatPattern.MatchString(email) {
+		fmt.Println("   ❌ 올바른 이메일 형식이 아니에요")
+		fmt.Println("   💡 예시: example@gmail.com")
+		fmt.Println()
+		return false
+	}
+	return true
+}
+
+// 쉼표로 구분한 이메일 목록의 모든 항목을 검증
+func validateEmailList(emails string) bool {
+	for _, email := range splitList(emails) {
+		if !validateEmail(email) {
+			fmt.Printf("   ↳ 잘못된 항목: %s\n\n", email)
+			return false
+		}
+	}
+	return true
+}
+
+func validatePassword(password string) bool {
+	if !validateRequired(password, "비밀번호") {
+		return false
+	}
+	if len(password) != 5 {
+		fmt.Println("   ❌ 비밀번호는 5자리여야 해요")
+		fmt.Println()
+		return false
+	}
+	if _, err := strconv.Atoi(password); err != nil {
+		fmt.Println("   ❌ 비밀번호는 숫자만 입력 가능해요")
+		fmt.Println()
+		return false
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+package lurker
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🧾 예약 작업 (여정과 승객 정보)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🧾 예약 작업 하나 (여정, 예약자, 알림 주소)
+// 입력 마법사, 명령행 플래그, 승객 프로필이 채우고 Run이 실행해요
+type Job struct {
+	ID   string // 작업 이름 (여러 작업을 함께 실행할 때 로그, 실행 기록, 아티팩트를 구분)
+	Mode string // "reserve"(자동 예약, 기본) 또는 "watch"(빈자리 감시)
+
+	From        string // 출발역 (예: "수서")
+	To          string // 도착역 (예: "부산")
+	Date        string // 출발 날짜 YYYYMMDD 또는 YYYY-MM-DD
+	DeptTime    string // 출발 시간 HH:MM 또는 HHMM (감시 모드에서 비우면 모든 열차)
+	ArrivalTime string // 도착 시간 HH:MM 또는 HHMM
+
+	CustomerType  string // "unregistered"(미등록 고객, 기본) 또는 "login"
+	Name          string // 미등록 고객 예약자 이름
+	Phone         string // 미등록 고객 전화번호 (010으로 시작하는 11자리)
+	Password      string // 미등록 고객 예약 비밀번호 (숫자 5자리)
+	LoginType     string // "member", "email", "phone"
+	LoginID       string // 로그인 ID (회원번호/이메일/전화번호)
+	LoginPassword string // 로그인 비밀번호
+	Credential    string // 보관함에 저장된 자격 증명 이름 (로그인 정보 대신 사용)
+
+	NotifyEmails []string // 알림받을 이메일 주소 목록
+	Priority     int      // 같은 열차를 노리는 다른 작업보다 먼저 빈자리를 잡을 우선순위 (클수록 먼저, 기본 0)
+}
+
+// ✅ 작업 내용을 실행할 수 있는 형태로 맞추고 문제를 모두 모으는 함수
+// 날짜와 시간 형식을 통일하고 비어 있는 모드/고객 유형에 기본값을 채워요
+func normalizeJob(job *Job) []string {
+	var problems []string
+
+	job.Mode = firstNonEmpty(job.Mode, "reserve")
+	if job.Mode != "reserve" && job.Mode != "watch" {
+		problems = append(problems, fmt.Sprintf("Mode 값은 reserve 또는 watch여야 해요: %s", job.Mode))
+	}
+
+	for _, station := range []struct{ field, value string }{{"From", job.From}, {"To", job.To}} {
+		if !isSRTStation(station.value) {
+			problems = append(problems, fmt.Sprintf("%s 값이 SRT 역이 아니에요: %s", station.field, station.value))
+		}
+	}
+	if job.From != "" && job.From == job.To {
+		problems = append(problems, "출발역과 도착역이 같아요")
+	}
+	if date, err := normalizeDate(job.Date); err != nil {
+		problems = append(problems, fmt.Sprintf("Date 값은 YYYYMMDD 또는 YYYY-MM-DD 형식이어야 해요: %s", job.Date))
+	} else {
+		job.Date = date
+	}
+	for _, clock := range []struct {
+		field string
+		value *string
+	}{{"DeptTime", &job.DeptTime}, {"ArrivalTime", &job.ArrivalTime}} {
+		if *clock.value == "" {
+			if job.Mode == "reserve" {
+				problems = append(problems, clock.field+" 값이 필요해요 (자동 예약은 열차를 시간으로 골라요)")
+			}
+			continue
+		}
+		normalized, err := normalizeClock(clock.field, *clock.value)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		*clock.value = normalized
+	}
+
+	for _, email := range job.NotifyEmails {
+		if !emailFormatPattern.MatchString(email) {
+			problems = append(problems, "NotifyEmails 값에 잘못된 이메일 주소가 있어요: "+email)
+		}
+	}
+
+	if job.Mode != "reserve" {
+		return problems
+	}
+	if job.CustomerType == "" && job.LoginID != "" {
+		job.CustomerType = "login"
+	}
+	switch firstNonEmpty(job.CustomerType, "unregistered") {
+	case "unregistered":
+		job.CustomerType = "unregistered"
+		if strings.TrimSpace(job.Name) == "" {
+			problems = append(problems, "Name 값이 필요해요 (미등록 고객 예약자 이름)")
+		}
+		if !profilePhonePattern.MatchString(job.Phone) {
+			problems = append(problems, "Phone 값은 010으로 시작하는 11자리 숫자여야 해요")
+		}
+		if !reservationPasswordPattern.MatchString(job.Password) {
+			problems = append(problems, "Password 값은 숫자 5자리여야 해요")
+		}
+	case "login":
+		if job.LoginType != "member" && job.LoginType != "email" && job.LoginType != "phone" {
+			problems = append(problems, fmt.Sprintf("LoginType 값은 member, email, phone 중 하나여야 해요: %s", job.LoginType))
+		}
+		if job.LoginID == "" || job.LoginPassword == "" {
+			problems = append(problems, "LoginID와 LoginPassword 값이 필요해요 (또는 보관함 자격 증명 Credential)")
+		}
+	default:
+		problems = append(problems, fmt.Sprintf("CustomerType 값은 unregistered 또는 login이어야 해요: %s", job.CustomerType))
+	}
+	return problems
+}
+
+// 미등록 고객 예약 비밀번호 형식 (숫자 5자리)
+var reservationPasswordPattern = regexp.MustCompile(`^\d{5}$`)
+
+// 📅 YYYYMMDD 또는 YYYY-MM-DD 날짜를 YYYYMMDD로 바꾸는 함수
+func normalizeDate(value string) (string, error) {
+	for _, layout := range []string{"20060102", "2006-01-02"} {
+		if date, err := time.ParseInLocation(layout, value, kstLocation); err == nil {
+			return date.Format("20060102"), nil
+		}
+	}
+	return "", fmt.Errorf("--date 값은 YYYYMMDD 또는 YYYY-MM-DD 형식이어야 해요: %s", value)
+}
+
+// ⏰ HHMM 또는 HH:MM 시간을 HH:MM으로 바꾸는 함수
+func normalizeClock(flagName, value string) (string, error) {
+	if len(value) == 4 && !strings.Contains(value, ":") {
+		value = value[:2] + ":" + value[2:]
+	}
+	if !profileTimePattern.MatchString(value) {
+		return "", fmt.Errorf("%s 값은 HHMM 또는 HH:MM 형식이어야 해요: %s", flagName, value)
+	}
+	return value, nil
+}
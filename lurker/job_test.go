@@ -0,0 +1,79 @@
+package lurker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeJob(t *testing.T) {
+	tests := []struct {
+		name     string
+		job      Job
+		want     Job // 문제가 없을 때 정리된 결과
+		problems int
+	}{
+		{
+			name: "미등록 고객 예약 (날짜와 시간 형식 정리, 기본 모드)",
+			job: Job{From: "수서", To: "부산", Date: "2026-03-01", DeptTime: "0800", ArrivalTime: "11:30",
+				Name: "홍길동", Phone: "01012345678", Password: "12345"},
+			want: Job{Mode: "reserve", From: "수서", To: "부산", Date: "20260301", DeptTime: "08:00", ArrivalTime: "11:30",
+				CustomerType: "unregistered", Name: "홍길동", Phone: "01012345678", Password: "12345"},
+		},
+		{
+			name: "로그인 ID가 있으면 로그인 고객",
+			job: Job{From: "수서", To: "동대구", Date: "20260301", DeptTime: "08:00", ArrivalTime: "10:00",
+				LoginType: "member", LoginID: "1234567890", LoginPassword: "secret"},
+			want: Job{Mode: "reserve", From: "수서", To: "동대구", Date: "20260301", DeptTime: "08:00", ArrivalTime: "10:00",
+				CustomerType: "login", LoginType: "member", LoginID: "1234567890", LoginPassword: "secret"},
+		},
+		{
+			name: "감시 모드는 시간과 예약자 정보가 없어도 됨",
+			job:  Job{Mode: "watch", From: "수서", To: "부산", Date: "20260301"},
+			want: Job{Mode: "watch", From: "수서", To: "부산", Date: "20260301"},
+		},
+		{
+			name:     "알 수 없는 모드",
+			job:      Job{Mode: "book", From: "수서", To: "부산", Date: "20260301"},
+			problems: 1,
+		},
+		{
+			name:     "SRT 역이 아니고 날짜 형식이 틀림",
+			job:      Job{Mode: "watch", From: "서울", To: "부산", Date: "3월 1일"},
+			problems: 2,
+		},
+		{
+			name:     "출발역과 도착역이 같음",
+			job:      Job{Mode: "watch", From: "부산", To: "부산", Date: "20260301"},
+			problems: 1,
+		},
+		{
+			name: "예약에 필요한 시간과 예약자 정보가 빠짐",
+			job:  Job{From: "수서", To: "부산", Date: "20260301", Phone: "0101234", Password: "12"},
+			// DeptTime, ArrivalTime, Name, Phone, Password
+			problems: 5,
+		},
+		{
+			name:     "잘못된 알림 이메일과 시간 형식",
+			job:      Job{Mode: "watch", From: "수서", To: "부산", Date: "20260301", DeptTime: "8시", NotifyEmails: []string{"me@"}},
+			problems: 2,
+		},
+		{
+			name: "로그인 정보가 부족함",
+			job: Job{From: "수서", To: "부산", Date: "20260301", DeptTime: "08:00", ArrivalTime: "10:00",
+				CustomerType: "login", LoginType: "kakao"},
+			problems: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := tt.job
+			problems := normalizeJob(&job)
+			if len(problems) != tt.problems {
+				t.Fatalf("문제 %d건, want %d건: %q", len(problems), tt.problems, problems)
+			}
+			if tt.problems == 0 && !reflect.DeepEqual(job, tt.want) {
+				t.Errorf("정리한 작업\n got %+v\nwant %+v", job, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Package lurker는 SRT 빈자리 예약/감시 자동화를 다른 Go 프로그램에서 쓸 수 있게 묶은 패키지예요.
+//
+// 명령행 도구(cmd/srt-lurker)는 Main을 호출하고, 다른 도구는 Job을 채워 Run을 호출하면 돼요.
+//
+//	if err := lurker.LoadConfig(""); err != nil {
+//		log.Fatal(err)
+//	}
+//	result, err := lurker.Run(ctx, lurker.Job{
+//		Mode: "watch", From: "수서", To: "부산", Date: "20260301",
+//	}, lurker.Options{Output: logFile})
+//
+// 진행 메시지는 모두 Options.Output(RunJobs는 BatchOptions.Output과 작업별 로그 파일)으로만 나가고,
+// 개인정보는 쓰기 전에 가려요. LoadConfig는 아무것도 출력하지 않아요.
+// Run과 RunJobs는 터미널에서 아무것도 묻지 않아요. 보관함 암호는 Options.VaultPassphrase나
+// VAULT_PASSPHRASE 환경변수로 알려주고, 알림 재시도 작업은 돌아오기 전에 멈춰요.
+//
+// 설정은 프로세스 전체에서 하나예요. LoadConfig가 읽은 메일, 접근 제어, 재시도, 알림, 셀렉터 프로필 설정은
+// 패키지 변수에 들어가고 같은 프로세스의 모든 Run/RunJobs 호출이 함께 써요. 작업마다 다른 설정이 필요하면
+// 설정을 바꿔 LoadConfig를 다시 부르기보다 프로세스를 나눠 실행하세요 (실행 중인 작업이 있을 때 다시 읽으면 안 돼요).
+package lurker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 📦 공개 API (Run, RunJobs, LoadConfig, Main)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🔑 보관함 암호를 알려주는 함수 (Job.Credential로 보관함 자격 증명을 쓸 때만 불러요)
+type SecretSource func() (string, error)
+
+// 🎛️ 작업 실행 옵션
+type Options struct {
+	Output io.Writer // 진행 메시지를 쓸 곳 (nil이면 표준 출력)
+	DryRun bool      // 최종 예약 확정 직전에 멈추고 결과만 보고 (예약하기 버튼은 실제로 누름)
+	// 보관함 암호 (nil이면 VAULT_PASSPHRASE 환경변수, 그것도 없으면 묻지 않고 오류)
+	VaultPassphrase SecretSource
+}
+
+// 🏁 작업 실행 결과
+type Result struct {
+	// "reserved", "dry-run", "failed", "stopped", "interrupted", "preflight-failed"
+	// 작업을 시작하지도 못했으면 빈 문자열
+	Outcome  string
+	Attempts int // 예약 시도 또는 감시 조회 횟수
+
+	TrainNumber       string    // 예약하기를 누른 열차 번호
+	ReservationNumber string    // 예약 완료 화면에서 읽은 예약번호 (못 읽으면 빈 값)
+	Seat              string    // 예약 완료 화면에서 읽은 좌석 (예: "3호차 7A")
+	PaymentDeadline   time.Time // 결제 기한 (못 읽으면 0)
+}
+
+// 🧵 여러 작업 실행 옵션
+type BatchOptions struct {
+	MaxConcurrent int       // 동시에 실행할 작업 수 (0이면 설정 jobs.maxConcurrent)
+	DryRun        bool      // 모든 작업을 최종 예약 확정 직전에 멈추고 결과만 보고
+	LogDir        string    // 작업별 로그 파일(<Job.ID>.log) 폴더 (비어 있으면 설정 jobs.logDir)
+	Output        io.Writer // 작업 이름을 앞에 붙인 진행 메시지를 모아 쓸 곳 (nil이면 표준 출력)
+	// 보관함 암호 (nil이면 VAULT_PASSPHRASE 환경변수, 그것도 없으면 묻지 않고 오류, 여러 작업이 한 번만 씀)
+	VaultPassphrase SecretSource
+}
+
+// 🏁 여러 작업 중 하나의 실행 결과 (RunJobs에 넘긴 순서와 같음)
+type BatchResult struct {
+	ID      string // 작업 이름 (Job.ID, 비어 있었으면 job-1, job-2, ...)
+	Result  Result
+	Err     error  // 작업이 실패했거나 시작하기 전에 중단된 이유
+	LogPath string // 이 작업의 로그 파일
+}
+
+var configLoad struct {
+	sync.Mutex
+	loaded bool
+}
+
+// 📂 설정(내장 기본값 → config.yaml → 환경변수)과 셀렉터 프로필(SELECTORS_FILE)을 읽고 점검하는 함수
+// path가 비어 있으면 명령행 도구와 같은 위치에서 config.yaml을 찾아요
+// Run은 설정을 읽지 않았으면 먼저 이 함수를 호출해요
+// 읽은 파일 안내는 쓰지 않고, 문제가 있으면 오류로만 알려줘요
+func LoadConfig(path string) error {
+	return loadLibraryConfig(path, io.Discard)
+}
+
+// 설정을 읽으며 안내 메시지를 out에 쓰는 함수 (Run, RunJobs가 설정을 처음 읽을 때는 작업 출력에 씀)
+func loadLibraryConfig(path string, out io.Writer) error {
+	configLoad.Lock()
+	defer configLoad.Unlock()
+
+	runOptions.configPath = path
+	loadConfig(out)
+	configLoad.loaded = true
+	if problems := validateConfig(); len(problems) > 0 {
+		return fmt.Errorf("설정에 문제가 %d건 있어요: %s", len(problems), strings.Join(problems, "; "))
+	}
+	return loadSelectorProfile(os.Getenv("SELECTORS_FILE"), out)
+}
+
+// 설정을 아직 읽지 않았으면 읽는 함수
+func ensureConfigLoaded(out io.Writer) error {
+	configLoad.Lock()
+	loaded := configLoad.loaded
+	configLoad.Unlock()
+	if loaded {
+		return nil
+	}
+	if out == nil {
+		out = os.Stdout
+	}
+	return loadLibraryConfig("", newRedactingWriter(out))
+}
+
+// 🚄 예약 작업 하나를 실행하는 함수 (브라우저를 띄우고 작업이 끝나면 닫음)
+// ctx가 취소되면 진행 중인 시도를 멈추고 중단 알림과 실행 기록을 남긴 뒤 돌아와요
+// 모든 시도가 실패하거나 사전 점검에 실패하면 마지막 오류를 함께 돌려줘요
+func Run(ctx context.Context, job Job, opts Options) (Result, error) {
+	if err := ensureConfigLoaded(opts.Output); err != nil {
+		return Result{}, err
+	}
+	return run(ctx, job, opts, nil)
+}
+
+// 🧵 작업 여러 개를 브라우저 하나로 함께 실행하는 함수 (작업마다 브라우저 컨텍스트, 상태, 로그 파일을 따로 가짐)
+// 동시에 MaxConcurrent개까지 jobs 순서대로 시작하고, 모든 작업이 끝나면 돌아와요
+// 작업 정보에 문제가 있으면 아무 작업도 시작하지 않고 오류를 돌려줘요
+func RunJobs(ctx context.Context, jobs []Job, opts BatchOptions) ([]BatchResult, error) {
+	if err := ensureConfigLoaded(opts.Output); err != nil {
+		return nil, err
+	}
+	return runBatch(ctx, jobs, opts)
+}
+
+// 🧭 명령행 도구의 진입점 (args는 프로그램 이름을 뺀 인자, 종료 코드 반환)
+func Main(args []string) int {
+	return runCLI(args)
+}
@@ -0,0 +1,80 @@
+package lurker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🎯 조회 결과 읽기 (열차별 좌석 상태)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🚆 조회 결과 한 줄에서 읽어낸 열차 좌석 상태
+type trainAvailability struct {
+	trainNumber string
+	deptText    string
+	arrivalText string
+	premium     string // 특실 상태 ("예약가능", "매진", "-")
+	standard    string // 일반실 상태 ("예약가능", "매진", "-")
+}
+
+func seatStatus(td playwright.Locator) string {
+	if count, _ := td.Locator(sel("reserveButton")).Count(); count > 0 {
+		return "예약가능"
+	}
+	if count, _ := td.Locator(sel("soldOut")).Count(); count > 0 {
+		return "매진"
+	}
+	return "-"
+}
+
+// 📋 조회 결과에서 설정한 출발/도착 시간과 일치하는 열차들의 좌석 상태를 읽는 함수
+func (s *session) readTrainAvailability(page playwright.Page) ([]trainAvailability, error) {
+	s.waitForNetfunnel(page)
+
+	trs, err := page.Locator(sel("trainRows")).All()
+	if err != nil {
+		return nil, err
+	}
+
+	var trains []trainAvailability
+	for _, tr := range trs {
+		tds, err := tr.Locator("td").All()
+		if err != nil || len(tds) < minColumnCount() {
+			continue
+		}
+
+		dept, err := tds[col("departure")].Locator("em").TextContent()
+		if err != nil {
+			continue
+		}
+		arrival, err := tds[col("arrival")].Locator("em").TextContent()
+		if err != nil {
+			continue
+		}
+
+		// 시간을 정하지 않았으면 (search 명령) 조회된 열차를 모두 읽음
+		if s.job.DeptTime != "" && !strings.Contains(dept, s.job.DeptTime) {
+			continue
+		}
+		if s.job.ArrivalTime != "" && !strings.Contains(arrival, s.job.ArrivalTime) {
+			continue
+		}
+
+		trainNumber, _ := tds[col("trainNumber")].TextContent()
+		trains = append(trains, trainAvailability{
+			trainNumber: strings.TrimSpace(trainNumber),
+			deptText:    strings.TrimSpace(dept),
+			arrivalText: strings.TrimSpace(arrival),
+			premium:     seatStatus(tds[col("premium")]),
+			standard:    seatStatus(tds[col("standard")]),
+		})
+	}
+
+	if len(trains) == 0 {
+		return nil, fmt.Errorf("조건에 맞는 열차를 찾을 수 없어요")
+	}
+	return trains, nil
+}
@@ -0,0 +1,208 @@
+package lurker
+
+import (
+	"strings"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🔔 알림 이벤트와 Notifier
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 📣 알림 이벤트 종류
+type notificationEventType string
+
+const (
+	eventStarted          notificationEventType = "started"           // 예약/감시 시작
+	eventAttemptMilestone notificationEventType = "attempt_milestone" // N번째 시도 도달
+	eventSeatFound        notificationEventType = "seat_found"        // 감시 모드에서 빈자리 발견
+	eventReserved         notificationEventType = "reserved"          // 예약 성공
+	eventFailed           notificationEventType = "failed"            // 모든 시도 실패
+	eventAborted          notificationEventType = "aborted"           // 사전 점검 실패, 사용자 중단 등
+	eventDigest           notificationEventType = "digest"            // 주기적 진행 요약
+)
+
+var allNotificationEvents = []notificationEventType{
+	eventStarted, eventAttemptMilestone, eventSeatFound, eventReserved, eventFailed, eventAborted, eventDigest,
+}
+
+// 🧾 알림에 담기는 여정 정보 (이벤트 시점의 passengerInfo 사본)
+type tripSummary struct {
+	deptStation    string
+	arrivalStation string
+	deptTime       string
+	arrivalTime    string
+	date           string
+	customerType   string
+	reserverName   string
+}
+
+// 📣 알림 이벤트
+type notificationEvent struct {
+	eventType   notificationEventType
+	time        time.Time
+	attempt     int
+	message     string
+	runMode     string
+	deepLink    string
+	trip        tripSummary
+	train       trainAvailability       // 관련 열차 (seat_found, reserved)
+	seatClass   string                  // 관련 좌석 등급 (seat_found)
+	reservation reservationConfirmation // 예약 확인 정보 (reserved)
+	digest      digestSummary           // 요약 기간 동안의 통계 (digest)
+	stats       attemptStats            // 이벤트 시점의 시도 통계
+}
+
+// 📊 이벤트 시점의 시도 통계
+type attemptStats struct {
+	attempts  int
+	failures  int
+	startedAt time.Time
+	lastError string
+}
+
+// 🔔 알림 채널 인터페이스
+type notifier interface {
+	name() string
+	notify(event notificationEvent) error
+}
+
+func (s *session) newNotificationEvent(eventType notificationEventType, attempt int, message string) notificationEvent {
+	reserverName := s.job.Name
+	if s.job.CustomerType == "login" {
+		reserverName = "회원정보 사용"
+	}
+
+	s.control.Lock()
+	stats := attemptStats{
+		attempts:  s.control.attempt,
+		failures:  s.control.failures,
+		startedAt: s.control.startedAt,
+		lastError: s.control.lastError,
+	}
+	s.control.Unlock()
+
+	return notificationEvent{
+		eventType: eventType,
+		time:      time.Now(),
+		attempt:   attempt,
+		message:   message,
+		runMode:   s.job.Mode,
+		deepLink:  s.watchDeepLink(),
+		stats:     stats,
+		trip: tripSummary{
+			deptStation:    s.job.From,
+			arrivalStation: s.job.To,
+			deptTime:       s.job.DeptTime,
+			arrivalTime:    s.job.ArrivalTime,
+			date:           s.job.Date,
+			customerType:   s.job.CustomerType,
+			reserverName:   reserverName,
+		},
+		train:       s.selectedTrain,
+		reservation: s.confirmedReservation,
+	}
+}
+
+// ⚙️ 입력받은 정보와 설정으로 알림 채널 목록을 구성하는 함수
+func (s *session) setupNotifiers() {
+	s.notifiers = nil
+
+	emailTo := append([]string{}, s.job.NotifyEmails...)
+	emailTo = append(emailTo, notificationRouting.emailTo...)
+	if len(emailTo) > 0 || len(notificationRouting.emailRoutes) > 0 {
+		email := &emailNotifier{
+			out: s.out,
+			recipients: emailRecipients{
+				to:  emailTo,
+				cc:  notificationRouting.emailCC,
+				bcc: notificationRouting.emailBCC,
+			},
+			routes: notificationRouting.emailRoutes,
+		}
+		s.notifiers = append(s.notifiers, routeNotifier("email", "", email))
+	}
+	if webhookConfig.slackURL != "" {
+		s.notifiers = append(s.notifiers, routeNotifier("slack", destinationKey(webhookConfig.slackURL), &slackNotifier{webhookURL: webhookConfig.slackURL}))
+	}
+	if webhookConfig.discordURL != "" {
+		s.notifiers = append(s.notifiers, routeNotifier("discord", destinationKey(webhookConfig.discordURL), &discordNotifier{webhookURL: webhookConfig.discordURL}))
+	}
+	if telegramConfig.botToken != "" && telegramConfig.chatID != "" {
+		telegram := newTelegramNotifier(s, telegramConfig.apiBase, telegramConfig.botToken, telegramConfig.chatID)
+		s.notifiers = append(s.notifiers, routeNotifier("telegram", destinationKey(telegramConfig.botToken, telegramConfig.chatID), telegram))
+		// 봇 하나는 명령을 한 곳에서만 받을 수 있으므로 여러 작업을 함께 실행할 때는 알림만 보냄
+		if s.shared {
+			s.println("   ℹ️ 여러 작업을 함께 실행 중이라 텔레그램 원격 명령은 받지 않아요")
+		} else {
+			go telegram.listenCommands()
+		}
+	}
+	if webhookConfig.genericURL != "" {
+		webhook, err := newWebhookNotifier(webhookConfig.genericURL, webhookConfig.templatePath)
+		if err != nil {
+			s.printf("   ⚠️ 웹훅 알림을 사용할 수 없어요: %v\n", err)
+		} else {
+			s.notifiers = append(s.notifiers, routeNotifier("webhook", destinationKey(webhookConfig.genericURL), webhook))
+		}
+	}
+
+	if desktopConfig.enabled {
+		s.notifiers = append(s.notifiers, routeNotifier("desktop", "local", newDesktopNotifier(s.out, desktopConfig.system)))
+	}
+
+	s.startNotificationQueue()
+
+	if len(s.notifiers) == 0 {
+		s.println("   ℹ️ 설정된 알림 채널이 없어요")
+		return
+	}
+	names := make([]string, 0, len(s.notifiers))
+	for _, n := range s.notifiers {
+		names = append(names, n.name())
+	}
+	s.printf("   🔔 알림 채널: %s\n", strings.Join(names, ", "))
+}
+
+// 🧭 설정한 이벤트만 통과시키는 알림 채널 래퍼
+type routedNotifier struct {
+	notifier
+	channel     string // "email", "slack", "discord", "telegram", "webhook"
+	destination string // 보낼 곳을 구분하는 키 (이메일은 비어 있고 수신자를 알림마다 저장)
+	events      map[notificationEventType]bool
+}
+
+func (r routedNotifier) accepts(eventType notificationEventType) bool {
+	return r.events[eventType]
+}
+
+func (r routedNotifier) notify(event notificationEvent) error {
+	if !r.events[event.eventType] {
+		return nil
+	}
+	return r.notifier.notify(event)
+}
+
+// 채널 종류에 맞는 이벤트 필터를 씌우는 함수 (NOTIFY_EVENTS_<채널> 또는 채널 기본값)
+func routeNotifier(channel, destination string, n notifier) routedNotifier {
+	events, ok := notificationRouting.channelEvents[channel]
+	if !ok {
+		events = map[notificationEventType]bool{}
+		for _, eventType := range defaultChannelEvents[channel] {
+			events[eventType] = true
+		}
+	}
+	return routedNotifier{notifier: n, channel: channel, destination: destination, events: events}
+}
+
+// 📤 모든 알림 채널로 이벤트를 보내는 함수
+// 채널마다 발송 큐에 넣고 바로 한 번 시도하며, 실패한 채널은 백그라운드에서 재시도해요
+func (s *session) dispatchNotification(event notificationEvent) {
+	for _, n := range s.notifiers {
+		if !n.accepts(event.eventType) {
+			continue
+		}
+		item := s.enqueueNotification(n, event)
+		deliverQueuedNotification(item)
+	}
+}
@@ -0,0 +1,131 @@
+package lurker
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🖥️ 로컬 알림 (터미널 벨, 깜빡이는 배너, 데스크톱 알림)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+const (
+	desktopAppName       = "SRT Lurker"
+	desktopBannerFlash   = 3                      // 배너 깜빡임 횟수
+	desktopFlashDelay    = 300 * time.Millisecond // 깜빡임 간격
+	desktopSystemTimeout = 5 * time.Second        // 데스크톱 알림 명령 제한 시간
+)
+
+// 🖥️ 자리에 있는 사용자를 위한 로컬 알림 채널
+type desktopNotifier struct {
+	out     io.Writer // 배너를 쓰는 곳 (알림을 보낸 작업의 출력)
+	command []string  // 데스크톱 알림 명령 (없으면 터미널 알림만)
+}
+
+// 현재 OS에서 쓸 수 있는 데스크톱 알림 명령을 찾는 함수
+func newDesktopNotifier(out io.Writer, system bool) *desktopNotifier {
+	n := &desktopNotifier{out: out}
+	if !system {
+		return n
+	}
+	switch runtime.GOOS {
+	case "linux", "freebsd", "openbsd":
+		if path, err := exec.LookPath("notify-send"); err == nil {
+			n.command = []string{path, "--app-name=" + desktopAppName, "--urgency=critical"}
+		}
+	case "darwin":
+		if path, err := exec.LookPath("osascript"); err == nil {
+			n.command = []string{path}
+		}
+	}
+	return n
+}
+
+func (n *desktopNotifier) name() string {
+	if len(n.command) > 0 {
+		return "로컬(" + filepath.Base(n.command[0]) + ")"
+	}
+	return "로컬"
+}
+
+func (n *desktopNotifier) notify(event notificationEvent) error {
+	content, err := renderNotification(event)
+	if err != nil {
+		return err
+	}
+
+	flashBanner(n.out, content.subject)
+	fmt.Fprintln(n.out, content.text)
+	fmt.Fprintln(n.out, strings.Repeat("═", 60))
+
+	// 데스크톱 알림은 부가 기능이라 실패해도 재시도하지 않음 (배너와 벨은 이미 표시됨)
+	if len(n.command) > 0 {
+		if err := n.showSystemNotification(content.subject, firstLine(content.text)); err != nil {
+			fmt.Fprintf(n.out, "   ⚠️ 데스크톱 알림 표시 실패: %v\n", err)
+		}
+	}
+	return nil
+}
+
+// 🔔 터미널 벨을 울리고 배너를 반전 색으로 깜빡이는 함수
+func flashBanner(out io.Writer, title string) {
+	banner := "  🔔 " + title + "  "
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, strings.Repeat("═", 60))
+	for i := 0; i < desktopBannerFlash; i++ {
+		fmt.Fprintf(out, "\a\r\x1b[7m\x1b[1m%s\x1b[0m", banner)
+		time.Sleep(desktopFlashDelay)
+		fmt.Fprintf(out, "\r%s", banner)
+		time.Sleep(desktopFlashDelay)
+	}
+	fmt.Fprintf(out, "\r\x1b[7m\x1b[1m%s\x1b[0m\n", banner)
+	fmt.Fprintln(out, strings.Repeat("═", 60))
+}
+
+func (n *desktopNotifier) showSystemNotification(title, body string) error {
+	args := append([]string{}, n.command[1:]...)
+	if runtime.GOOS == "darwin" {
+		script := fmt.Sprintf("display notification %s with title %s sound name \"Glass\"",
+			appleScriptString(body), appleScriptString(desktopAppName+" - "+title))
+		args = append(args, "-e", script)
+	} else {
+		args = append(args, "--", title, body)
+	}
+
+	cmd := exec.Command(n.command[0], args...)
+	done := make(chan error, 1)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(desktopSystemTimeout):
+		cmd.Process.Kill()
+		return fmt.Errorf("%v 안에 응답하지 않았어요", desktopSystemTimeout)
+	}
+}
+
+// AppleScript 문자열 리터럴로 감싸는 함수
+func appleScriptString(value string) string {
+	value = strings.ReplaceAll(value, "\\", "\\\\")
+	value = strings.ReplaceAll(value, "\"", "\\\"")
+	return "\"" + value + "\""
+}
+
+// 빈 줄이 아닌 첫 줄을 돌려주는 함수
+func firstLine(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
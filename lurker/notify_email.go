@@ -0,0 +1,280 @@
+package lurker
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 📧 이메일 알림 (SMTP)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 📬 메일 수신자 목록
+type emailRecipients struct {
+	to  []string
+	cc  []string
+	bcc []string
+}
+
+// SMTP RCPT로 보낼 전체 주소 (중복 제거)
+func (r emailRecipients) all() []string {
+	seen := map[string]bool{}
+	var all []string
+	for _, list := range [][]string{r.to, r.cc, r.bcc} {
+		for _, address := range list {
+			key := strings.ToLower(address)
+			if !seen[key] {
+				seen[key] = true
+				all = append(all, address)
+			}
+		}
+	}
+	return all
+}
+
+// 📧 SMTP 메일 알림 채널
+type emailNotifier struct {
+	out        io.Writer // 발송 결과를 쓰는 곳 (알림을 보낸 작업의 출력)
+	recipients emailRecipients
+	routes     map[notificationEventType][]string // 이벤트별 수신자 (지정 시 기본 수신자, 참조, 숨은 참조 대신 사용)
+}
+
+func (n *emailNotifier) name() string {
+	return "이메일(" + strings.Join(n.recipients.to, ", ") + ")"
+}
+
+// 이벤트에 맞는 수신자 목록
+// 수신자를 따로 정한 이벤트는 그 주소로만 보내요 (기본 참조/숨은 참조도 받지 않음)
+func (n *emailNotifier) recipientsFor(eventType notificationEventType) emailRecipients {
+	if routed, ok := n.routes[eventType]; ok {
+		return emailRecipients{to: routed}
+	}
+	return n.recipients
+}
+
+func (n *emailNotifier) notify(event notificationEvent) error {
+	recipients := n.recipientsFor(event.eventType)
+	if len(recipients.to) == 0 {
+		return nil
+	}
+
+	content, err := renderNotification(event)
+	if err != nil {
+		return err
+	}
+	if event.eventType == eventReserved {
+		content.attachments = append(content.attachments, mailAttachment{
+			fileName:    tripCalendarFileName(event),
+			contentType: "text/calendar; charset=UTF-8; method=PUBLISH",
+			data:        buildTripCalendar(event),
+		})
+	}
+	return n.send(recipients, content)
+}
+
+func (n *emailNotifier) send(recipients emailRecipients, content renderedNotification) error {
+	msg := buildMailMessage(emailConfig.senderEmail, recipients, content)
+	if err := sendMail(recipients.all(), msg); err != nil {
+		return fmt.Errorf("이메일 발송 실패: %w", err)
+	}
+
+	fmt.Fprintf(n.out, "   ✅ 알림 이메일이 발송되었어요 (%d명)\n", len(recipients.all()))
+	return nil
+}
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 📮 SMTP 메일 전송 (SMTPS, STARTTLS, 제한 시간)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// ✉️ 헤더를 모두 갖춘 메일 원문을 만드는 함수 (HTML 본문이 있으면 multipart/alternative)
+func buildMailMessage(from string, recipients emailRecipients, content renderedNotification) []byte {
+	domain := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 {
+		domain = from[at+1:]
+	}
+	randomID := make([]byte, 8)
+	rand.Read(randomID)
+
+	var msg bytes.Buffer
+	msg.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	msg.WriteString("From: " + (&mail.Address{Name: "SRT Lurker", Address: from}).String() + "\r\n")
+	msg.WriteString("To: " + strings.Join(recipients.to, ", ") + "\r\n")
+	if len(recipients.cc) > 0 {
+		msg.WriteString("Cc: " + strings.Join(recipients.cc, ", ") + "\r\n")
+	}
+	// Bcc는 헤더에 쓰지 않고 RCPT로만 전달
+	msg.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", content.subject) + "\r\n")
+	msg.WriteString(fmt.Sprintf("Message-ID: <%d.%s@%s>\r\n", time.Now().UnixNano(), hex.EncodeToString(randomID), domain))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+
+	bodyType, body := mailBody(content)
+	if len(content.attachments) == 0 {
+		msg.WriteString("Content-Type: " + bodyType + "\r\n")
+		if !strings.HasPrefix(bodyType, "multipart/") {
+			msg.WriteString("Content-Transfer-Encoding: base64\r\n")
+		}
+		msg.WriteString("\r\n")
+		msg.Write(body)
+		return msg.Bytes()
+	}
+
+	// 첨부 파일이 있으면 본문과 첨부를 multipart/mixed로 묶음
+	var parts bytes.Buffer
+	writer := multipart.NewWriter(&parts)
+	msg.WriteString("Content-Type: multipart/mixed; boundary=" + writer.Boundary() + "\r\n")
+	msg.WriteString("\r\n")
+
+	bodyHeader := textproto.MIMEHeader{"Content-Type": {bodyType}}
+	if !strings.HasPrefix(bodyType, "multipart/") {
+		bodyHeader.Set("Content-Transfer-Encoding", "base64")
+	}
+	bodyWriter, _ := writer.CreatePart(bodyHeader)
+	bodyWriter.Write(body)
+
+	for _, attachment := range content.attachments {
+		partWriter, _ := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {attachment.contentType + "; name=\"" + attachment.fileName + "\""},
+			"Content-Disposition":       {"attachment; filename=\"" + attachment.fileName + "\""},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		var encoded bytes.Buffer
+		writeBase64Lines(&encoded, attachment.data)
+		partWriter.Write(encoded.Bytes())
+	}
+	writer.Close()
+
+	msg.Write(parts.Bytes())
+	return msg.Bytes()
+}
+
+// 메일 본문의 Content-Type과 인코딩된 내용을 만드는 함수 (HTML이 있으면 multipart/alternative)
+func mailBody(content renderedNotification) (string, []byte) {
+	var body bytes.Buffer
+	if content.html == "" {
+		writeBase64Lines(&body, []byte(content.text))
+		return "text/plain; charset=UTF-8", body.Bytes()
+	}
+
+	writer := multipart.NewWriter(&body)
+	// 메일 클라이언트는 마지막 파트를 우선하므로 text → html 순서
+	for _, part := range []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=UTF-8", content.text},
+		{"text/html; charset=UTF-8", content.html},
+	} {
+		partWriter, _ := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		var encoded bytes.Buffer
+		writeBase64Lines(&encoded, []byte(part.body))
+		partWriter.Write(encoded.Bytes())
+	}
+	writer.Close()
+	return "multipart/alternative; boundary=" + writer.Boundary(), body.Bytes()
+}
+
+// RFC 2045에 맞게 76자마다 줄을 바꿔 base64로 쓰는 함수
+func writeBase64Lines(buf *bytes.Buffer, data []byte) {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+}
+
+// 📮 설정한 TLS 방식과 제한 시간으로 SMTP 서버에 메일을 보내는 함수
+func sendMail(recipients []string, msg []byte) error {
+	host := emailConfig.smtpHost
+	addr := net.JoinHostPort(host, emailConfig.smtpPort)
+	tlsConfig := &tls.Config{ServerName: host}
+
+	tlsMode := emailConfig.tlsMode
+	if tlsMode == "auto" || tlsMode == "" {
+		tlsMode = "starttls"
+		if emailConfig.smtpPort == "465" {
+			tlsMode = "implicit"
+		}
+	}
+
+	dialer := &net.Dialer{Timeout: emailConfig.timeout}
+	var conn net.Conn
+	var err error
+	switch tlsMode {
+	case "implicit":
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, tlsConfig)
+	case "starttls", "none":
+		conn, err = dialer.Dial("tcp", addr)
+	default:
+		return fmt.Errorf("알 수 없는 SMTP_TLS_MODE: %s (auto, implicit, starttls, none 중 하나)", emailConfig.tlsMode)
+	}
+	if err != nil {
+		return fmt.Errorf("SMTP 서버 연결 실패 (%s): %w", addr, err)
+	}
+	// 연결부터 발송 완료까지 전체 제한 시간
+	conn.SetDeadline(time.Now().Add(emailConfig.timeout))
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("SMTP 인사 실패: %w", err)
+	}
+	defer client.Close()
+
+	if tlsMode == "starttls" {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return fmt.Errorf("SMTP 서버가 STARTTLS를 지원하지 않아요 (암호화 없이 보내지 않아요)")
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("STARTTLS 실패: %w", err)
+		}
+	}
+
+	if emailConfig.senderPass != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("SMTP 서버가 인증(AUTH)을 지원하지 않아요")
+		}
+		auth := smtp.PlainAuth("", emailConfig.senderEmail, emailConfig.senderPass, host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP 인증 실패: %w", err)
+		}
+	}
+
+	if err := client.Mail(emailConfig.senderEmail); err != nil {
+		return fmt.Errorf("발신자 설정 실패: %w", err)
+	}
+	for _, recipient := range recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("수신자 설정 실패 (%s): %w", recipient, err)
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("메일 본문 전송 시작 실패: %w", err)
+	}
+	if _, err := writer.Write(msg); err != nil {
+		return fmt.Errorf("메일 본문 전송 실패: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("메일 본문 전송 완료 실패: %w", err)
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,207 @@
+package lurker
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildMailMessage(t *testing.T) {
+	recipients := emailRecipients{
+		to:  []string{"a@example.com", "b@example.com"},
+		cc:  []string{"lead@example.com"},
+		bcc: []string{"archive@example.com"},
+	}
+	tests := []struct {
+		name        string
+		content     renderedNotification
+		contentType string
+	}{
+		{"텍스트만", renderedNotification{subject: "🎉 예약 성공", text: "본문"}, "text/plain"},
+		{"텍스트와 HTML", renderedNotification{subject: "🎉 예약 성공", text: "본문", html: "<p>본문</p>"}, "multipart/alternative"},
+		{"첨부 파일", renderedNotification{subject: "🎉 예약 성공", text: "본문", attachments: []mailAttachment{
+			{fileName: "srt.ics", contentType: "text/calendar; charset=UTF-8", data: []byte("BEGIN:VCALENDAR")},
+		}}, "multipart/mixed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := buildMailMessage("bot@example.com", recipients, tt.content)
+			msg, err := mail.ReadMessage(bytes.NewReader(raw))
+			if err != nil {
+				t.Fatalf("메일 원문을 해석할 수 없어요: %v", err)
+			}
+
+			if got := msg.Header.Get("To"); got != "a@example.com, b@example.com" {
+				t.Errorf("To = %q", got)
+			}
+			if got := msg.Header.Get("Cc"); got != "lead@example.com" {
+				t.Errorf("Cc = %q", got)
+			}
+			if got := msg.Header.Get("Bcc"); got != "" || bytes.Contains(raw, []byte("archive@example.com")) {
+				t.Error("숨은 참조 주소가 메일 원문에 들어갔어요")
+			}
+			if from, err := msg.Header.AddressList("From"); err != nil || from[0].Address != "bot@example.com" {
+				t.Errorf("From = %q (%v)", msg.Header.Get("From"), err)
+			}
+			subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+			if err != nil || subject != tt.content.subject {
+				t.Errorf("Subject = %q (%v), want %q", subject, err, tt.content.subject)
+			}
+			if id := msg.Header.Get("Message-ID"); !strings.HasSuffix(id, "@example.com>") {
+				t.Errorf("Message-ID = %q", id)
+			}
+			if _, err := msg.Header.Date(); err != nil {
+				t.Errorf("Date 헤더를 해석할 수 없어요: %v", err)
+			}
+			mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+			if err != nil || mediaType != tt.contentType {
+				t.Errorf("Content-Type = %q (%v), want %s", mediaType, err, tt.contentType)
+			}
+			if strings.HasPrefix(mediaType, "multipart/") && params["boundary"] == "" {
+				t.Error("multipart에 boundary가 없어요")
+			}
+		})
+	}
+}
+
+func TestEmailRecipientsFor(t *testing.T) {
+	n := &emailNotifier{
+		recipients: emailRecipients{to: []string{"me@example.com"}, cc: []string{"lead@example.com"}, bcc: []string{"archive@example.com"}},
+		routes:     map[notificationEventType][]string{eventFailed: {"ops@example.com"}},
+	}
+	tests := []struct {
+		name      string
+		eventType notificationEventType
+		want      emailRecipients
+	}{
+		{"기본 수신자", eventReserved, n.recipients},
+		{"이벤트별 수신자는 참조/숨은 참조 없이", eventFailed, emailRecipients{to: []string{"ops@example.com"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := n.recipientsFor(tt.eventType); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// 📮 명령을 기록하고 모두 성공으로 답하는 간단한 SMTP 서버 (TLS, 인증 없음)
+type fakeSMTPServer struct {
+	listener net.Listener
+	done     chan struct{}
+	commands []string
+	data     string
+}
+
+func startFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &fakeSMTPServer{listener: listener, done: make(chan struct{})}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		defer close(server.done)
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.TrimRight(line, "\r\n")
+			server.commands = append(server.commands, command)
+			switch verb := strings.ToUpper(strings.SplitN(command, " ", 2)[0]); verb {
+			case "EHLO", "HELO":
+				reply("250 localhost")
+			case "DATA":
+				reply("354 end with .")
+				var data strings.Builder
+				for {
+					line, err := reader.ReadString('\n')
+					if err != nil || line == ".\r\n" {
+						break
+					}
+					data.WriteString(line)
+				}
+				server.data = data.String()
+				reply("250 queued")
+			case "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+	return server
+}
+
+func TestSendMail(t *testing.T) {
+	server := startFakeSMTPServer(t)
+	host, port, _ := net.SplitHostPort(server.listener.Addr().String())
+	saved := emailConfig
+	defer func() { emailConfig = saved }()
+	emailConfig.smtpHost = host
+	emailConfig.smtpPort = port
+	emailConfig.senderEmail = "bot@example.com"
+	emailConfig.senderPass = ""
+	emailConfig.tlsMode = "none"
+	emailConfig.timeout = 5 * time.Second
+
+	recipients := emailRecipients{to: []string{"a@example.com"}, bcc: []string{"archive@example.com"}}
+	msg := buildMailMessage(emailConfig.senderEmail, recipients, renderedNotification{subject: "테스트", text: "본문"})
+	if err := sendMail(recipients.all(), msg); err != nil {
+		t.Fatalf("sendMail: %v", err)
+	}
+	<-server.done
+
+	want := []string{"MAIL FROM:<bot@example.com>", "RCPT TO:<a@example.com>", "RCPT TO:<archive@example.com>", "DATA", "QUIT"}
+	var got []string
+	for _, command := range server.commands {
+		if !strings.HasPrefix(command, "EHLO") && !strings.HasPrefix(command, "HELO") {
+			got = append(got, strings.SplitN(command, " BODY=", 2)[0])
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SMTP 명령\n got %q\nwant %q", got, want)
+	}
+	if !strings.Contains(server.data, "To: a@example.com\r\n") {
+		t.Errorf("받은 메일 원문에 To 헤더가 없어요:\n%s", server.data)
+	}
+}
+
+func TestSendMailRequiresStartTLS(t *testing.T) {
+	server := startFakeSMTPServer(t)
+	host, port, _ := net.SplitHostPort(server.listener.Addr().String())
+	saved := emailConfig
+	defer func() { emailConfig = saved }()
+	emailConfig.smtpHost = host
+	emailConfig.smtpPort = port
+	emailConfig.senderEmail = "bot@example.com"
+	emailConfig.tlsMode = "starttls"
+	emailConfig.timeout = 5 * time.Second
+
+	err := sendMail([]string{"a@example.com"}, []byte("Subject: x\r\n\r\nbody\r\n"))
+	if err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Errorf("STARTTLS가 없는 서버에 암호화 없이 보냈어요 (err=%v)", err)
+	}
+}
@@ -0,0 +1,576 @@
+package lurker
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 📬 알림 발송 큐 (재시도, 디스크 보관, 파일 대체 발송)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+const (
+	maxNotificationAttempts = 8                // 채널별 최대 발송 시도 횟수
+	notificationBaseBackoff = 5 * time.Second  // 첫 재시도 대기 시간 (이후 2배씩 증가)
+	notificationMaxBackoff  = 5 * time.Minute  // 재시도 대기 시간 상한
+	notificationFlushWait   = 30 * time.Second // 종료 전 남은 알림을 기다리는 최대 시간
+	notificationLeaseRenew  = 30 * time.Second // 보내는 중인 알림 파일의 수정 시각을 갱신하는 간격
+	notificationLease       = 2 * time.Minute  // 이 시간 동안 갱신이 없으면 주인 프로세스가 없는 알림으로 봄
+)
+
+// 📬 발송 대기 중인 알림 하나 (채널 + 이벤트)
+type queuedNotification struct {
+	ID          string                  `json:"id"`
+	Channel     string                  `json:"channel"`
+	Event       storedNotificationEvent `json:"event"`
+	Attempts    int                     `json:"attempts"`
+	NextAttempt time.Time               `json:"nextAttempt"`
+	LastError   string                  `json:"lastError,omitempty"`
+	Destination string                  `json:"destination,omitempty"` // 채널의 보낼 곳 키 (이메일 제외)
+	Recipients  *storedRecipients       `json:"recipients,omitempty"`  // 알림을 넣을 때 정한 이메일 수신자
+	inFlight    bool
+	notifier    *routedNotifier // 알림을 넣은 작업의 채널 (디스크에서 읽은 알림은 nil)
+	session     *session        // 진행 메시지를 쓸 작업 (알림을 넣었거나 이전 실행의 알림을 가져온 작업)
+}
+
+// 💾 디스크에 보관하는 이메일 수신자
+type storedRecipients struct {
+	To  []string `json:"to"`
+	CC  []string `json:"cc,omitempty"`
+	BCC []string `json:"bcc,omitempty"`
+}
+
+// 💾 디스크에 보관하는 이벤트 형식
+type storedNotificationEvent struct {
+	Type        notificationEventType `json:"type"`
+	Time        time.Time             `json:"time"`
+	Attempt     int                   `json:"attempt"`
+	Message     string                `json:"message"`
+	RunMode     string                `json:"runMode"`
+	DeepLink    string                `json:"deepLink"`
+	SeatClass   string                `json:"seatClass"`
+	Trip        map[string]string     `json:"trip"`
+	Train       map[string]string     `json:"train"`
+	Reservation struct {
+		Number          string    `json:"number,omitempty"`
+		Seat            string    `json:"seat,omitempty"`
+		TrainNumber     string    `json:"trainNumber,omitempty"`
+		PaymentDeadline time.Time `json:"paymentDeadline,omitempty"`
+	} `json:"reservation"`
+	Digest struct {
+		PeriodStart   time.Time           `json:"periodStart,omitempty"`
+		PeriodEnd     time.Time           `json:"periodEnd,omitempty"`
+		Attempts      int                 `json:"attempts,omitempty"`
+		Outcomes      map[string]int      `json:"outcomes,omitempty"`
+		QueueWaits    int                 `json:"queueWaits,omitempty"`
+		QueueWaitTime time.Duration       `json:"queueWaitTime,omitempty"`
+		TrainsSeen    []map[string]string `json:"trainsSeen,omitempty"`
+	} `json:"digest"`
+	Stats struct {
+		Attempts  int       `json:"attempts"`
+		Failures  int       `json:"failures"`
+		StartedAt time.Time `json:"startedAt"`
+		LastError string    `json:"lastError"`
+	} `json:"stats"`
+}
+
+var notificationQueue = struct {
+	sync.Mutex
+	items        []*queuedNotification
+	sessions     map[*session]bool         // 큐를 쓰고 있는 실행 중인 작업 (모두 끝나면 재시도 작업을 멈춤)
+	cancel       context.CancelFunc        // 재시도 작업을 멈춤 (재시도 작업이 없으면 nil)
+	stopped      chan struct{}             // 재시도 작업이 끝나면 닫힘
+	destinations map[string]routedNotifier // 이번 실행에 설정된 채널 (채널 + 보낼 곳 키 → 채널)
+	session      *session                  // 가장 최근에 시작한 작업 (실행 중에 가져온 알림의 메시지를 씀)
+}{}
+
+// 🔑 채널의 보낼 곳(웹훅 주소, 봇 토큰 등)을 디스크에 남기지 않고 구분하는 키
+func destinationKey(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+func storeNotificationEvent(event notificationEvent) storedNotificationEvent {
+	stored := storedNotificationEvent{
+		Type:      event.eventType,
+		Time:      event.time,
+		Attempt:   event.attempt,
+		Message:   event.message,
+		RunMode:   event.runMode,
+		DeepLink:  event.deepLink,
+		SeatClass: event.seatClass,
+		Trip: map[string]string{
+			"deptStation":    event.trip.deptStation,
+			"arrivalStation": event.trip.arrivalStation,
+			"deptTime":       event.trip.deptTime,
+			"arrivalTime":    event.trip.arrivalTime,
+			"date":           event.trip.date,
+			"customerType":   event.trip.customerType,
+			"reserverName":   event.trip.reserverName,
+		},
+		Train: storeTrain(event.train),
+	}
+	stored.Reservation.Number = event.reservation.reservationNumber
+	stored.Reservation.Seat = event.reservation.seat
+	stored.Reservation.TrainNumber = event.reservation.trainNumber
+	stored.Reservation.PaymentDeadline = event.reservation.paymentDeadline
+	stored.Digest.PeriodStart = event.digest.periodStart
+	stored.Digest.PeriodEnd = event.digest.periodEnd
+	stored.Digest.Attempts = event.digest.attempts
+	stored.Digest.Outcomes = event.digest.outcomes
+	stored.Digest.QueueWaits = event.digest.queueWaits
+	stored.Digest.QueueWaitTime = event.digest.queueWaitTime
+	for _, train := range event.digest.trainsSeen {
+		stored.Digest.TrainsSeen = append(stored.Digest.TrainsSeen, storeTrain(train))
+	}
+	stored.Stats.Attempts = event.stats.attempts
+	stored.Stats.Failures = event.stats.failures
+	stored.Stats.StartedAt = event.stats.startedAt
+	stored.Stats.LastError = event.stats.lastError
+	return stored
+}
+
+func (stored storedNotificationEvent) event() notificationEvent {
+	return notificationEvent{
+		eventType: stored.Type,
+		time:      stored.Time,
+		attempt:   stored.Attempt,
+		message:   stored.Message,
+		runMode:   stored.RunMode,
+		deepLink:  stored.DeepLink,
+		seatClass: stored.SeatClass,
+		trip: tripSummary{
+			deptStation:    stored.Trip["deptStation"],
+			arrivalStation: stored.Trip["arrivalStation"],
+			deptTime:       stored.Trip["deptTime"],
+			arrivalTime:    stored.Trip["arrivalTime"],
+			date:           stored.Trip["date"],
+			customerType:   stored.Trip["customerType"],
+			reserverName:   stored.Trip["reserverName"],
+		},
+		train: loadTrain(stored.Train),
+		reservation: reservationConfirmation{
+			reservationNumber: stored.Reservation.Number,
+			seat:              stored.Reservation.Seat,
+			trainNumber:       stored.Reservation.TrainNumber,
+			paymentDeadline:   stored.Reservation.PaymentDeadline,
+		},
+		digest: digestSummary{
+			periodStart:   stored.Digest.PeriodStart,
+			periodEnd:     stored.Digest.PeriodEnd,
+			attempts:      stored.Digest.Attempts,
+			outcomes:      stored.Digest.Outcomes,
+			queueWaits:    stored.Digest.QueueWaits,
+			queueWaitTime: stored.Digest.QueueWaitTime,
+			trainsSeen:    loadTrains(stored.Digest.TrainsSeen),
+		},
+		stats: attemptStats{
+			attempts:  stored.Stats.Attempts,
+			failures:  stored.Stats.Failures,
+			startedAt: stored.Stats.StartedAt,
+			lastError: stored.Stats.LastError,
+		},
+	}
+}
+
+func storeTrain(train trainAvailability) map[string]string {
+	return map[string]string{
+		"trainNumber": train.trainNumber,
+		"deptText":    train.deptText,
+		"arrivalText": train.arrivalText,
+		"premium":     train.premium,
+		"standard":    train.standard,
+	}
+}
+
+func loadTrain(stored map[string]string) trainAvailability {
+	return trainAvailability{
+		trainNumber: stored["trainNumber"],
+		deptText:    stored["deptText"],
+		arrivalText: stored["arrivalText"],
+		premium:     stored["premium"],
+		standard:    stored["standard"],
+	}
+}
+
+func loadTrains(stored []map[string]string) []trainAvailability {
+	var trains []trainAvailability
+	for _, train := range stored {
+		trains = append(trains, loadTrain(train))
+	}
+	return trains
+}
+
+// 📮 알림을 보낼 채널을 찾는 함수
+// 이전 실행에서 남은 알림은 저장한 수신자나 같은 보낼 곳으로만 보내고, 찾지 못하면 대체 파일에 기록해요
+func notifierForItem(item *queuedNotification) (notifier, bool) {
+	if item.notifier != nil {
+		return item.notifier.notifier, true
+	}
+	if item.Channel == "email" {
+		if item.Recipients == nil {
+			return nil, false
+		}
+		return &emailNotifier{out: item.session.out, recipients: emailRecipients{
+			to:  item.Recipients.To,
+			cc:  item.Recipients.CC,
+			bcc: item.Recipients.BCC,
+		}}, true
+	}
+	if item.Destination == "" {
+		return nil, false
+	}
+
+	notificationQueue.Lock()
+	defer notificationQueue.Unlock()
+	n, ok := notificationQueue.destinations[item.Channel+"|"+item.Destination]
+	return n.notifier, ok
+}
+
+// 큐에 알림을 넣고 디스크에 기록하는 함수 (발송 전에 먼저 기록해야 중간에 죽어도 남음)
+func (s *session) enqueueNotification(n routedNotifier, event notificationEvent) *queuedNotification {
+	randomID := make([]byte, 4)
+	rand.Read(randomID)
+
+	item := &queuedNotification{
+		ID:          fmt.Sprintf("%d-%s", time.Now().UnixNano(), hex.EncodeToString(randomID)),
+		Channel:     n.channel,
+		Event:       storeNotificationEvent(event),
+		NextAttempt: time.Now(),
+		Destination: n.destination,
+		inFlight:    true,
+		notifier:    &n,
+		session:     s,
+	}
+	// 이메일은 작업마다 수신자가 달라서 이 알림의 수신자를 함께 저장함
+	if email, ok := n.notifier.(*emailNotifier); ok {
+		recipients := email.recipientsFor(event.eventType)
+		item.Recipients = &storedRecipients{To: recipients.to, CC: recipients.cc, BCC: recipients.bcc}
+	}
+
+	notificationQueue.Lock()
+	notificationQueue.items = append(notificationQueue.items, item)
+	saveQueuedNotificationLocked(item)
+	notificationQueue.Unlock()
+	return item
+}
+
+// 📤 큐의 알림 하나를 발송하고 결과에 따라 큐를 정리하는 함수
+func deliverQueuedNotification(item *queuedNotification) {
+	n, ok := notifierForItem(item)
+	var err error
+	if ok {
+		err = n.notify(item.Event.event())
+	} else {
+		err = fmt.Errorf("이번 실행에 이 알림을 보낼 %s 채널이 설정되어 있지 않아요", item.Channel)
+	}
+
+	notificationQueue.Lock()
+	defer notificationQueue.Unlock()
+	item.inFlight = false
+
+	if err == nil {
+		removeQueuedNotificationLocked(item)
+		return
+	}
+
+	item.Attempts++
+	item.LastError = redact(err.Error())
+	if !ok || item.Attempts >= maxNotificationAttempts {
+		item.session.printf("   ⚠️ %s 알림을 끝내 보내지 못해 대체 파일에 기록할게요: %s\n", item.Channel, item.LastError)
+		writeFallbackNotification(item)
+		removeQueuedNotificationLocked(item)
+		return
+	}
+	backoff := notificationBaseBackoff << (item.Attempts - 1)
+	if backoff > notificationMaxBackoff {
+		backoff = notificationMaxBackoff
+	}
+	item.NextAttempt = time.Now().Add(backoff)
+	item.session.printf("   ⚠️ %s 알림 발송 실패 (%d/%d), %v 후 재시도: %s\n",
+		item.Channel, item.Attempts, maxNotificationAttempts, backoff, item.LastError)
+	saveQueuedNotificationLocked(item)
+}
+
+func removeQueuedNotificationLocked(item *queuedNotification) {
+	for i, queued := range notificationQueue.items {
+		if queued == item {
+			notificationQueue.items = append(notificationQueue.items[:i], notificationQueue.items[i+1:]...)
+			break
+		}
+	}
+	os.Remove(item.path())
+}
+
+// 알림 하나마다 파일 하나를 써서 여러 프로세스가 서로의 알림을 덮어쓰지 않게 함
+func notificationQueueDir() string {
+	dir := filepath.Join(stateDir(), "notification-queue")
+	os.MkdirAll(dir, 0o700)
+	return dir
+}
+
+func (item *queuedNotification) path() string {
+	return filepath.Join(notificationQueueDir(), item.ID+".json")
+}
+
+// 예전 버전이 모든 알림을 한 파일에 저장하던 경로
+func legacyNotificationQueuePath() string {
+	return filepath.Join(stateDir(), "notification-queue.json")
+}
+
+func notificationFallbackPath() string {
+	return filepath.Join(stateDir(), "notifications-undelivered.log")
+}
+
+func saveQueuedNotificationLocked(item *queuedNotification) {
+	// 임시 파일에 쓴 뒤 교체해서 쓰는 도중에 죽어도 알림 파일이 깨지지 않게 함
+	if err := writeJSONFile(item.path(), item); err != nil {
+		item.session.printf("   ⚠️ 알림 큐 저장 실패: %v\n", err)
+	}
+}
+
+// 📝 모든 재시도가 실패한 알림을 로컬 파일에 남기는 함수 (최종 대체 채널)
+func writeFallbackNotification(item *queuedNotification) {
+	text := item.Event.Message
+	if content, err := renderNotification(item.Event.event()); err == nil {
+		text = content.subject + "\n" + content.text
+	}
+	text = redact(text)
+
+	entry := fmt.Sprintf("===== %s | %s | %s | 시도 %d회 | 마지막 오류: %s\n%s\n\n",
+		time.Now().Format(time.RFC3339), item.Channel, item.Event.Type, item.Attempts, item.LastError, text)
+
+	file, err := os.OpenFile(notificationFallbackPath(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		item.session.printf("   ❌ 대체 알림 파일도 쓸 수 없어요: %v\n%s", err, entry)
+		return
+	}
+	defer file.Close()
+	file.WriteString(entry)
+	item.session.printf("   📝 대체 알림 파일에 기록했어요: %s\n", notificationFallbackPath())
+}
+
+// ▶ 이전 실행에서 남은 알림을 가져오고 재시도 작업을 시작하는 함수
+// 작업이 끝나면 stopNotificationQueue로 큐를 놓아야 해요 (마지막 작업이 놓으면 재시도 작업이 멈춤)
+func (s *session) startNotificationQueue() {
+	notificationQueue.Lock()
+	defer notificationQueue.Unlock()
+	notificationQueue.session = s
+	if notificationQueue.sessions == nil {
+		notificationQueue.sessions = map[*session]bool{}
+	}
+	notificationQueue.sessions[s] = true
+	if notificationQueue.destinations == nil {
+		notificationQueue.destinations = map[string]routedNotifier{}
+	}
+	for _, n := range s.notifiers {
+		if n.destination != "" {
+			notificationQueue.destinations[n.channel+"|"+n.destination] = n
+		}
+	}
+	if notificationQueue.cancel != nil {
+		return
+	}
+
+	if pending := claimOrphanedNotifications(s); len(pending) > 0 {
+		s.printf("   📬 이전 실행에서 보내지 못한 알림 %d건을 다시 보낼게요\n", len(pending))
+		notificationQueue.items = append(pending, notificationQueue.items...)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	notificationQueue.cancel, notificationQueue.stopped = cancel, stopped
+	go func() {
+		defer close(stopped)
+		renewAt := time.Now().Add(notificationLeaseRenew)
+		for {
+			for _, item := range dueNotifications() {
+				if ctx.Err() != nil {
+					releaseNotification(item)
+					continue
+				}
+				deliverQueuedNotification(item)
+			}
+			if time.Now().After(renewAt) {
+				renewNotificationLeases()
+				renewAt = time.Now().Add(notificationLeaseRenew)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+	}()
+}
+
+// ⏹ 작업이 끝날 때 큐를 놓는 함수
+// 남은 작업이 있으면 이 작업의 알림을 그 작업에 넘기고, 마지막 작업이면 재시도 작업이 멈출 때까지 기다려요
+// 보내지 못한 알림은 큐 폴더에 남아 다음 실행(또는 다른 프로세스)이 다시 보내요
+func (s *session) stopNotificationQueue() {
+	notificationQueue.Lock()
+	if !notificationQueue.sessions[s] {
+		notificationQueue.Unlock()
+		return
+	}
+	delete(notificationQueue.sessions, s)
+	for other := range notificationQueue.sessions {
+		if notificationQueue.session == s {
+			notificationQueue.session = other
+		}
+		for _, item := range notificationQueue.items {
+			if item.session == s {
+				item.session = other
+			}
+		}
+		notificationQueue.Unlock()
+		return
+	}
+
+	cancel, stopped := notificationQueue.cancel, notificationQueue.stopped
+	notificationQueue.cancel, notificationQueue.stopped = nil, nil
+	notificationQueue.Unlock()
+	if cancel != nil {
+		cancel()
+		<-stopped
+	}
+
+	// 파일 갱신을 멈췄으니 메모리의 알림은 버리고, 파일은 주인 없는 알림으로 다음 실행이 가져가게 함
+	notificationQueue.Lock()
+	notificationQueue.items = nil
+	notificationQueue.session = nil
+	notificationQueue.Unlock()
+}
+
+// 보내지 않고 다시 대기 상태로 돌려놓는 함수 (재시도 작업이 멈추는 중일 때)
+func releaseNotification(item *queuedNotification) {
+	notificationQueue.Lock()
+	item.inFlight = false
+	notificationQueue.Unlock()
+}
+
+// 📥 주인 프로세스가 사라진 알림 파일을 이 프로세스로 가져오는 함수
+// 파일 이름을 바꾸는 쪽 하나만 성공하므로 다른 프로세스가 보내는 중인 알림을 두 번 보내지 않아요
+func claimOrphanedNotifications(s *session) []*queuedNotification {
+	var pending []*queuedNotification
+
+	// 예전 버전의 큐 파일은 통째로 가져와서 알림마다 파일로 나눔
+	legacy := legacyNotificationQueuePath()
+	claimed := fmt.Sprintf("%s.claim-%d", legacy, os.Getpid())
+	if os.Rename(legacy, claimed) == nil {
+		var items []*queuedNotification
+		if data, err := os.ReadFile(claimed); err != nil || json.Unmarshal(data, &items) != nil {
+			s.printf("   ⚠️ 예전 알림 큐 파일을 읽을 수 없어요 (무시): %s\n", legacy)
+		}
+		for _, item := range items {
+			item.NextAttempt = time.Now()
+			item.session = s
+			saveQueuedNotificationLocked(item)
+			pending = append(pending, item)
+		}
+		os.Remove(claimed)
+	}
+
+	paths, _ := filepath.Glob(filepath.Join(notificationQueueDir(), "*.json"))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil || time.Since(info.ModTime()) < notificationLease {
+			continue // 다른 프로세스가 아직 보내는 중
+		}
+		claimed := fmt.Sprintf("%s.claim-%d", path, os.Getpid())
+		if os.Rename(path, claimed) != nil {
+			continue // 다른 프로세스가 먼저 가져감
+		}
+
+		var item queuedNotification
+		data, err := os.ReadFile(claimed)
+		if err == nil {
+			err = json.Unmarshal(data, &item)
+		}
+		if err != nil || item.ID == "" {
+			s.printf("   ⚠️ 알림 큐 파일을 읽을 수 없어요 (무시): %s\n", path)
+			os.Rename(claimed, path+".broken")
+			continue
+		}
+		item.NextAttempt = time.Now()
+		item.session = s
+		saveQueuedNotificationLocked(&item)
+		os.Remove(claimed)
+		pending = append(pending, &item)
+	}
+	return pending
+}
+
+// 🔄 이 프로세스가 가진 알림 파일의 수정 시각을 갱신하는 함수 (다른 프로세스가 가져가지 않게)
+// 실행 중에 끝난 다른 프로세스가 남긴 알림도 이때 가져와요
+func renewNotificationLeases() {
+	notificationQueue.Lock()
+	defer notificationQueue.Unlock()
+	now := time.Now()
+	for _, item := range notificationQueue.items {
+		os.Chtimes(item.path(), now, now)
+	}
+	notificationQueue.items = append(notificationQueue.items, claimOrphanedNotifications(notificationQueue.session)...)
+}
+
+func dueNotifications() []*queuedNotification {
+	notificationQueue.Lock()
+	defer notificationQueue.Unlock()
+
+	var due []*queuedNotification
+	now := time.Now()
+	for _, item := range notificationQueue.items {
+		if !item.inFlight && !item.NextAttempt.After(now) {
+			item.inFlight = true
+			due = append(due, item)
+		}
+	}
+	return due
+}
+
+// ⏳ 종료 전에 이 작업의 남은 알림을 바로 재시도하고 잠시 기다리는 함수
+// 그래도 남은 알림은 큐 폴더에 남아 다음 실행 때 다시 보내요
+func (s *session) flushNotifications() {
+	if s.pendingNotifications(true) == 0 {
+		return
+	}
+
+	s.println("   📬 남은 알림을 보내는 중이에요...")
+	deadline := time.Now().Add(notificationFlushWait)
+	for time.Now().Before(deadline) {
+		if s.pendingNotifications(false) == 0 {
+			return
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	if remaining := s.pendingNotifications(false); remaining > 0 {
+		s.printf("   ⚠️ 알림 %d건을 보내지 못했어요. 다음 실행 때 다시 보낼게요 (%s)\n", remaining, notificationQueueDir())
+	}
+}
+
+// 이 작업이 가진 남은 알림 수 (retryNow면 바로 다시 보내도록 표시)
+func (s *session) pendingNotifications(retryNow bool) int {
+	notificationQueue.Lock()
+	defer notificationQueue.Unlock()
+	count := 0
+	for _, item := range notificationQueue.items {
+		if item.session != s {
+			continue
+		}
+		count++
+		if retryNow {
+			item.NextAttempt = time.Now()
+		}
+	}
+	return count
+}
@@ -0,0 +1,66 @@
+package lurker
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestNotificationQueueStopsWithLastJob(t *testing.T) {
+	savedStateDir := stateDirOverride
+	stateDirOverride = t.TempDir()
+	defer func() { stateDirOverride = savedStateDir }()
+
+	first := newSession(context.Background(), Job{ID: "alice", Mode: "watch"}, &bytes.Buffer{})
+	second := newSession(context.Background(), Job{ID: "bob", Mode: "watch"}, &bytes.Buffer{})
+	first.startNotificationQueue()
+	second.startNotificationQueue()
+
+	notificationQueue.Lock()
+	stopped := notificationQueue.stopped
+	notificationQueue.Unlock()
+	if stopped == nil {
+		t.Fatal("재시도 작업이 시작되지 않았어요")
+	}
+
+	// 먼저 끝난 작업의 남은 알림 (재시도 작업이 바로 보내지 않도록 다음 시도를 미룸)
+	webhookURL := "http://127.0.0.1:1/hook"
+	item := first.enqueueNotification(routeNotifier("slack", destinationKey(webhookURL), &slackNotifier{webhookURL: webhookURL}), testReservedEvent())
+	notificationQueue.Lock()
+	item.inFlight = false
+	item.NextAttempt = time.Now().Add(time.Hour)
+	notificationQueue.Unlock()
+
+	first.stopNotificationQueue()
+	select {
+	case <-stopped:
+		t.Fatal("다른 작업이 남아 있는데 재시도 작업이 멈췄어요")
+	default:
+	}
+	notificationQueue.Lock()
+	owner := item.session
+	notificationQueue.Unlock()
+	if owner != second {
+		t.Error("먼저 끝난 작업의 알림이 남은 작업으로 넘어가지 않았어요")
+	}
+
+	second.stopNotificationQueue()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("마지막 작업이 끝났는데 재시도 작업이 멈추지 않았어요")
+	}
+	notificationQueue.Lock()
+	remaining, running := len(notificationQueue.items), notificationQueue.cancel != nil
+	notificationQueue.Unlock()
+	if remaining != 0 || running {
+		t.Errorf("멈춘 뒤 메모리에 알림 %d건, 재시도 작업 %v", remaining, running)
+	}
+	if _, err := os.Stat(item.path()); err != nil {
+		t.Errorf("보내지 못한 알림 파일은 다음 실행을 위해 남아 있어야 해요: %v", err)
+	}
+
+	second.stopNotificationQueue() // 두 번 놓아도 문제없음
+}
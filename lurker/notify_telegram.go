@@ -0,0 +1,185 @@
+package lurker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🤖 텔레그램 봇 알림 및 원격 명령
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// getUpdates 롱 폴링 대기 시간 (초)
+const telegramPollTimeout = 25
+
+// 🤖 텔레그램 봇 알림 채널 (설정한 채팅방의 /status, /pause, /resume, /stop 명령도 처리)
+type telegramNotifier struct {
+	session *session // 명령을 받을 작업
+	apiBase string
+	token   string
+	chatID  string
+	client  *http.Client
+}
+
+type telegramUpdate struct {
+	UpdateID int `json:"update_id"`
+	Message  *struct {
+		Text string `json:"text"`
+		Date int64  `json:"date"` // 보낸 시각 (Unix 초)
+		Chat struct {
+			ID int64 `json:"id"`
+		} `json:"chat"`
+	} `json:"message"`
+}
+
+func newTelegramNotifier(session *session, apiBase, token, chatID string) *telegramNotifier {
+	return &telegramNotifier{
+		session: session,
+		apiBase: apiBase,
+		token:   token,
+		chatID:  chatID,
+		client:  &http.Client{Timeout: (telegramPollTimeout + 10) * time.Second},
+	}
+}
+
+func (n *telegramNotifier) name() string {
+	return "Telegram"
+}
+
+func (n *telegramNotifier) endpoint(method string) string {
+	return n.apiBase + "/bot" + n.token + "/" + method
+}
+
+func (n *telegramNotifier) notify(event notificationEvent) error {
+	content, err := renderNotification(event)
+	if err != nil {
+		return err
+	}
+	return n.sendMessage(content.subject + "\n\n" + content.text)
+}
+
+func (n *telegramNotifier) sendMessage(text string) error {
+	payload, err := json.Marshal(map[string]string{
+		"chat_id": n.chatID,
+		"text":    redact(text),
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := n.client.Post(n.endpoint("sendMessage"), "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("텔레그램 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("텔레그램 응답 오류 (%s): %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// 📥 봇에 들어온 명령을 롱 폴링으로 받아 처리하는 함수 (고루틴으로 실행, 작업이 끝나면 돌아옴)
+func (n *telegramNotifier) listenCommands() {
+	n.session.control.Lock()
+	startedAt := n.session.control.startedAt
+	n.session.control.Unlock()
+
+	// 실행 전에 쌓인 명령(예전 /stop 등)은 건너뛰고 마지막 업데이트 다음부터 받음
+	offset := 0
+	if backlog, err := n.getUpdates(-1, 0); err == nil && len(backlog) > 0 {
+		offset = backlog[len(backlog)-1].UpdateID + 1
+	}
+
+	for !n.session.finished() {
+		updates, err := n.getUpdates(offset, telegramPollTimeout)
+		if err != nil {
+			n.session.printf("   ⚠️ 텔레그램 명령 수신 실패 (재시도): %v\n", err)
+			n.session.sleep(5 * time.Second)
+			continue
+		}
+
+		for _, update := range updates {
+			offset = update.UpdateID + 1
+			if update.Message == nil {
+				continue
+			}
+			// 설정한 채팅방에서 온 명령만 처리
+			if strconv.FormatInt(update.Message.Chat.ID, 10) != n.chatID {
+				continue
+			}
+			// 건너뛰기에 실패했을 때를 대비해 실행 전에 보낸 명령도 무시
+			if update.Message.Date < startedAt.Unix() {
+				continue
+			}
+			n.handleCommand(update.Message.Text)
+		}
+	}
+}
+
+// offset이 음수면 마지막 업데이트만 받고 그 이전 업데이트는 모두 확인 처리돼요
+func (n *telegramNotifier) getUpdates(offset, timeout int) ([]telegramUpdate, error) {
+	query := url.Values{}
+	query.Set("offset", strconv.Itoa(offset))
+	query.Set("timeout", strconv.Itoa(timeout))
+
+	resp, err := n.client.Get(n.endpoint("getUpdates") + "?" + query.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		OK          bool             `json:"ok"`
+		Description string           `json:"description"`
+		Result      []telegramUpdate `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("응답 해석 실패: %w", err)
+	}
+	if !result.OK {
+		return nil, fmt.Errorf("텔레그램 오류: %s", result.Description)
+	}
+	return result.Result, nil
+}
+
+func (n *telegramNotifier) handleCommand(text string) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return
+	}
+	// "/status@my_bot" 형태도 허용
+	command := strings.SplitN(fields[0], "@", 2)[0]
+
+	var reply string
+	switch command {
+	case "/status":
+		reply = n.session.runStatusText()
+	case "/pause":
+		n.session.setPaused(true)
+		reply = "⏸️ 다음 시도부터 일시정지할게요. /resume으로 재개할 수 있어요"
+	case "/resume":
+		n.session.setPaused(false)
+		reply = "▶ 다시 시작할게요"
+	case "/stop":
+		n.session.requestStop()
+		reply = "⛔ 진행 중인 시도가 끝나면 중지할게요"
+	case "/help", "/start":
+		reply = "사용 가능한 명령: /status, /pause, /resume, /stop"
+	default:
+		return
+	}
+
+	n.session.printf("   🤖 텔레그램 명령 수신: %s\n", command)
+	if err := n.sendMessage(reply); err != nil {
+		n.session.printf("   ⚠️ 텔레그램 응답 실패: %v\n", err)
+	}
+}
@@ -0,0 +1,144 @@
+package lurker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 🤖 텔레그램 Bot API 흉내 (보낸 메시지를 모으고 getUpdates에는 updates로 답함)
+type fakeTelegramAPI struct {
+	sync.Mutex
+	sent    []string
+	offsets []string
+	updates func(offset string) string // getUpdates 응답의 result JSON 배열
+}
+
+func (api *fakeTelegramAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/bottoken/sendMessage":
+		var payload map[string]string
+		json.NewDecoder(r.Body).Decode(&payload)
+		api.Lock()
+		api.sent = append(api.sent, payload["chat_id"]+": "+payload["text"])
+		api.Unlock()
+		fmt.Fprint(w, `{"ok":true}`)
+	case r.URL.Path == "/bottoken/getUpdates":
+		offset := r.URL.Query().Get("offset")
+		api.Lock()
+		api.offsets = append(api.offsets, offset)
+		api.Unlock()
+		result := "[]"
+		if api.updates != nil {
+			result = api.updates(offset)
+		}
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprintf(w, `{"ok":true,"result":%s}`, result)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (api *fakeTelegramAPI) messages() []string {
+	api.Lock()
+	defer api.Unlock()
+	return append([]string(nil), api.sent...)
+}
+
+func newTestTelegram(t *testing.T, api *fakeTelegramAPI) (*telegramNotifier, *session) {
+	t.Helper()
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	s := newSession(context.Background(), Job{ID: "alice", Mode: "reserve", From: "수서", To: "부산"}, &bytes.Buffer{})
+	return newTelegramNotifier(s, server.URL, "token", "42"), s
+}
+
+func TestTelegramHandleCommand(t *testing.T) {
+	tests := []struct {
+		command string
+		reply   string // 비어 있으면 답하지 않음
+		check   func(s *session) bool
+	}{
+		{"/pause", "일시정지할게요", func(s *session) bool { return s.control.paused }},
+		{"/resume", "다시 시작할게요", func(s *session) bool { return !s.control.paused }},
+		{"/status@srt_bot", "작업 alice", nil},
+		{"/help", "/status, /pause, /resume, /stop", nil},
+		{"/stop", "중지할게요", func(s *session) bool { return s.stopRequested() }},
+		{"안녕하세요", "", nil},
+		{"", "", nil},
+	}
+
+	api := &fakeTelegramAPI{}
+	n, s := newTestTelegram(t, api)
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			before := len(api.messages())
+			n.handleCommand(tt.command)
+			sent := api.messages()[before:]
+
+			if tt.reply == "" {
+				if len(sent) != 0 {
+					t.Errorf("답하지 않아야 하는데 %q를 보냈어요", sent)
+				}
+				return
+			}
+			if len(sent) != 1 || !strings.HasPrefix(sent[0], "42: ") || !strings.Contains(sent[0], tt.reply) {
+				t.Errorf("보낸 메시지 %q, want 채팅방 42에 %q", sent, tt.reply)
+			}
+			if tt.check != nil && !tt.check(s) {
+				t.Errorf("%s 명령이 작업 상태에 반영되지 않았어요", tt.command)
+			}
+		})
+	}
+}
+
+func TestTelegramSkipsBacklog(t *testing.T) {
+	started := time.Now()
+	api := &fakeTelegramAPI{}
+	api.updates = func(offset string) string {
+		switch offset {
+		case "-1": // 실행 전에 쌓인 마지막 명령
+			return `[{"update_id":41,"message":{"text":"/stop","date":1,"chat":{"id":42}}}]`
+		case "42":
+			return fmt.Sprintf(`[
+				{"update_id":42,"message":{"text":"/stop","date":%d,"chat":{"id":42}}},
+				{"update_id":43,"message":{"text":"/pause","date":%d,"chat":{"id":7}}},
+				{"update_id":44,"message":{"text":"/pause","date":%d,"chat":{"id":42}}}
+			]`, started.Add(-time.Hour).Unix(), started.Unix()+1, started.Unix()+1)
+		}
+		return "[]"
+	}
+	n, s := newTestTelegram(t, api)
+
+	go n.listenCommands()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) && len(api.messages()) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(s.done)
+
+	if s.stopRequested() {
+		t.Error("실행 전에 보낸 /stop을 처리했어요")
+	}
+	s.control.Lock()
+	paused := s.control.paused
+	s.control.Unlock()
+	if !paused {
+		t.Error("실행 중에 보낸 /pause를 처리하지 않았어요")
+	}
+	if sent := api.messages(); len(sent) != 1 {
+		t.Errorf("보낸 메시지 %q, want /pause 응답 하나 (다른 채팅방 명령은 무시)", sent)
+	}
+	api.Lock()
+	defer api.Unlock()
+	if len(api.offsets) < 2 || api.offsets[0] != "-1" || api.offsets[1] != "42" {
+		t.Errorf("getUpdates offset 순서 = %q, want -1, 42, ...", api.offsets)
+	}
+}
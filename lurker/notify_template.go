@@ -0,0 +1,237 @@
+package lurker
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 📝 알림 메시지 템플릿 (text/template, html/template)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+//go:embed templates/*.tmpl
+var builtinTemplates embed.FS
+
+// 📝 렌더링된 알림 메시지
+type renderedNotification struct {
+	subject     string
+	text        string
+	html        string
+	attachments []mailAttachment // 메일에만 붙는 첨부 파일
+}
+
+// 📎 메일 첨부 파일
+type mailAttachment struct {
+	fileName    string
+	contentType string
+	data        []byte
+}
+
+// 📦 알림 템플릿에서 사용할 수 있는 데이터
+type notificationData struct {
+	Event            string
+	Time             string
+	Attempt          int
+	Message          string
+	ModeText         string // "자동 예약" 또는 "빈자리 감시"
+	CustomerTypeText string // "미등록고객" 또는 "로그인고객"
+	SeatClass        string
+	DeepLink         string
+	Trip             struct {
+		DeptStation    string
+		ArrivalStation string
+		DeptTime       string
+		ArrivalTime    string
+		Date           string
+		CustomerType   string
+		ReserverName   string
+	}
+	Train struct {
+		Number    string
+		Departure string
+		Arrival   string
+		Premium   string
+		Standard  string
+	}
+	Reservation struct {
+		Number          string
+		Seat            string
+		PaymentDeadline string // "2006-01-02 15:04" (KST), 읽지 못했으면 빈 문자열
+	}
+	Digest struct {
+		Period        string // 요약 기간 길이 (예: "30m0s")
+		Since         string // 요약 기간 시작 시각
+		Attempts      int
+		Outcomes      []digestOutcome // 횟수가 많은 순
+		QueueWaits    int
+		QueueWaitTime string
+		TrainsSeen    []digestTrain
+	}
+	Stats struct {
+		Attempts  int
+		Failures  int
+		StartedAt string
+		Elapsed   string
+		LastError string
+	}
+}
+
+// 요약 알림의 결과 분류별 횟수
+type digestOutcome struct {
+	Class string
+	Count int
+}
+
+// 요약 기간에 본 열차
+type digestTrain struct {
+	Number    string
+	Departure string
+	Arrival   string
+	Premium   string
+	Standard  string
+}
+
+func newNotificationData(event notificationEvent) notificationData {
+	var data notificationData
+	data.Event = string(event.eventType)
+	data.Time = event.time.Format(time.RFC3339)
+	data.Attempt = event.attempt
+	data.Message = event.message
+	data.SeatClass = event.seatClass
+	data.DeepLink = event.deepLink
+
+	data.ModeText = "자동 예약"
+	if event.runMode == "watch" {
+		data.ModeText = "빈자리 감시"
+	}
+	switch event.trip.customerType {
+	case "unregistered":
+		data.CustomerTypeText = "미등록고객"
+	case "login":
+		data.CustomerTypeText = "로그인고객"
+	}
+
+	data.Trip.DeptStation = event.trip.deptStation
+	data.Trip.ArrivalStation = event.trip.arrivalStation
+	data.Trip.DeptTime = event.trip.deptTime
+	data.Trip.ArrivalTime = event.trip.arrivalTime
+	data.Trip.Date = event.trip.date
+	data.Trip.CustomerType = event.trip.customerType
+	data.Trip.ReserverName = event.trip.reserverName
+
+	data.Train.Number = event.train.trainNumber
+	data.Train.Departure = event.train.deptText
+	data.Train.Arrival = event.train.arrivalText
+	data.Train.Premium = event.train.premium
+	data.Train.Standard = event.train.standard
+
+	data.Reservation.Number = event.reservation.reservationNumber
+	data.Reservation.Seat = event.reservation.seat
+	if !event.reservation.paymentDeadline.IsZero() {
+		data.Reservation.PaymentDeadline = event.reservation.paymentDeadline.In(kstLocation).Format("2006-01-02 15:04")
+	}
+	if data.Train.Number == "" {
+		data.Train.Number = event.reservation.trainNumber
+	}
+
+	if event.eventType == eventDigest {
+		data.Digest.Period = event.digest.periodEnd.Sub(event.digest.periodStart).Round(time.Second).String()
+		data.Digest.Since = event.digest.periodStart.Format("2006-01-02 15:04:05")
+		data.Digest.Attempts = event.digest.attempts
+		for class, count := range event.digest.outcomes {
+			data.Digest.Outcomes = append(data.Digest.Outcomes, digestOutcome{Class: class, Count: count})
+		}
+		sort.Slice(data.Digest.Outcomes, func(i, j int) bool {
+			if data.Digest.Outcomes[i].Count != data.Digest.Outcomes[j].Count {
+				return data.Digest.Outcomes[i].Count > data.Digest.Outcomes[j].Count
+			}
+			return data.Digest.Outcomes[i].Class < data.Digest.Outcomes[j].Class
+		})
+		data.Digest.QueueWaits = event.digest.queueWaits
+		data.Digest.QueueWaitTime = event.digest.queueWaitTime.Round(time.Second).String()
+		for _, train := range event.digest.trainsSeen {
+			data.Digest.TrainsSeen = append(data.Digest.TrainsSeen, digestTrain{
+				Number:    train.trainNumber,
+				Departure: train.deptText,
+				Arrival:   train.arrivalText,
+				Premium:   train.premium,
+				Standard:  train.standard,
+			})
+		}
+	}
+
+	data.Stats.Attempts = event.stats.attempts
+	data.Stats.Failures = event.stats.failures
+	data.Stats.StartedAt = event.stats.startedAt.Format("2006-01-02 15:04:05")
+	data.Stats.Elapsed = event.time.Sub(event.stats.startedAt).Round(time.Second).String()
+	data.Stats.LastError = event.stats.lastError
+
+	return data
+}
+
+// 📖 이벤트의 템플릿 원문을 읽는 함수
+// NOTIFICATION_TEMPLATE_DIR/<이벤트>.tmpl 파일이 있으면 내장 템플릿 대신 사용해요
+func notificationTemplateSource(eventType notificationEventType) (string, error) {
+	fileName := string(eventType) + ".tmpl"
+	if notificationTemplateDir != "" {
+		data, err := os.ReadFile(filepath.Join(notificationTemplateDir, fileName))
+		if err == nil {
+			return string(data), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("알림 템플릿 파일을 읽을 수 없어요: %w", err)
+		}
+	}
+
+	data, err := builtinTemplates.ReadFile("templates/" + fileName)
+	if err != nil {
+		return "", fmt.Errorf("%s 이벤트의 내장 템플릿이 없어요", eventType)
+	}
+	return string(data), nil
+}
+
+// 📝 이벤트를 제목/텍스트 본문/HTML 본문으로 렌더링하는 함수
+// 템플릿 파일에는 "subject", "text", "html" 세 개의 define 블록이 있어야 해요
+func renderNotification(event notificationEvent) (renderedNotification, error) {
+	source, err := notificationTemplateSource(event.eventType)
+	if err != nil {
+		return renderedNotification{}, err
+	}
+	data := newNotificationData(event)
+
+	textTmpl, err := texttemplate.New(string(event.eventType)).Parse(source)
+	if err != nil {
+		return renderedNotification{}, fmt.Errorf("알림 템플릿 형식이 올바르지 않아요 (%s): %w", event.eventType, err)
+	}
+	htmlTmpl, err := htmltemplate.New(string(event.eventType)).Parse(source)
+	if err != nil {
+		return renderedNotification{}, fmt.Errorf("알림 템플릿 형식이 올바르지 않아요 (%s): %w", event.eventType, err)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := textTmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return renderedNotification{}, fmt.Errorf("알림 제목 렌더링 실패 (%s): %w", event.eventType, err)
+	}
+	if err := textTmpl.ExecuteTemplate(&text, "text", data); err != nil {
+		return renderedNotification{}, fmt.Errorf("알림 본문 렌더링 실패 (%s): %w", event.eventType, err)
+	}
+	if err := htmlTmpl.ExecuteTemplate(&html, "html", data); err != nil {
+		return renderedNotification{}, fmt.Errorf("알림 HTML 렌더링 실패 (%s): %w", event.eventType, err)
+	}
+
+	return renderedNotification{
+		subject: redact(strings.TrimSpace(subject.String())),
+		text:    redact(strings.TrimSpace(text.String())),
+		html:    redact(strings.TrimSpace(html.String())),
+	}, nil
+}
@@ -0,0 +1,187 @@
+package lurker
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 💬 웹훅 알림 (Slack, Discord, 일반 JSON 웹훅)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+const (
+	webhookTimeout       = 10 * time.Second
+	discordContentLimit  = 2000 // Discord 메시지 최대 길이
+	defaultWebhookFormat = `{
+  "event": {{json .Event}},
+  "time": {{json .Time}},
+  "attempt": {{.Attempt}},
+  "subject": {{json .Subject}},
+  "message": {{json .Message}},
+  "trip": {
+    "deptStation": {{json .Trip.DeptStation}},
+    "arrivalStation": {{json .Trip.ArrivalStation}},
+    "deptTime": {{json .Trip.DeptTime}},
+    "arrivalTime": {{json .Trip.ArrivalTime}},
+    "date": {{json .Trip.Date}},
+    "customerType": {{json .Trip.CustomerType}}
+  },
+  "train": {
+    "number": {{json .Train.Number}},
+    "departure": {{json .Train.Departure}},
+    "arrival": {{json .Train.Arrival}},
+    "seatClass": {{json .SeatClass}}
+  }
+}`
+)
+
+var webhookHTTPClient = &http.Client{Timeout: webhookTimeout}
+
+// 📦 웹훅 템플릿에서 사용할 수 있는 데이터 (알림 템플릿 데이터 + 렌더링된 제목/본문)
+type webhookPayloadData struct {
+	notificationData
+	Subject string
+	Body    string
+}
+
+func newWebhookPayloadData(event notificationEvent) (webhookPayloadData, error) {
+	content, err := renderNotification(event)
+	if err != nil {
+		return webhookPayloadData{}, err
+	}
+	return webhookPayloadData{
+		notificationData: newNotificationData(event),
+		Subject:          content.subject,
+		Body:             content.text,
+	}, nil
+}
+
+// 📮 JSON 본문을 웹훅 URL로 보내는 함수
+func postJSON(webhookURL string, payload []byte) error {
+	payload = []byte(redact(string(payload)))
+	resp, err := webhookHTTPClient.Post(webhookURL, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		// 요청 오류에는 비밀 토큰이 든 웹훅 URL이 그대로 들어 있어서 URL은 빼고 원인만 남겨요
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("웹훅 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("웹훅 응답 오류 (%s): %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// 💬 Slack Incoming Webhook 알림 채널
+type slackNotifier struct {
+	webhookURL string
+}
+
+func (n *slackNotifier) name() string {
+	return "Slack"
+}
+
+func (n *slackNotifier) notify(event notificationEvent) error {
+	content, err := renderNotification(event)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(map[string]string{
+		"text": "*" + content.subject + "*\n" + content.text,
+	})
+	if err != nil {
+		return err
+	}
+	return postJSON(n.webhookURL, payload)
+}
+
+// 💬 Discord Webhook 알림 채널
+type discordNotifier struct {
+	webhookURL string
+}
+
+func (n *discordNotifier) name() string {
+	return "Discord"
+}
+
+func (n *discordNotifier) notify(event notificationEvent) error {
+	content, err := renderNotification(event)
+	if err != nil {
+		return err
+	}
+	message := []rune("**" + content.subject + "**\n" + content.text)
+	if len(message) > discordContentLimit {
+		message = append(message[:discordContentLimit-1], '…')
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"content": string(message),
+	})
+	if err != nil {
+		return err
+	}
+	return postJSON(n.webhookURL, payload)
+}
+
+// 🔗 페이로드 템플릿을 쓰는 일반 JSON 웹훅 알림 채널
+type webhookNotifier struct {
+	webhookURL string
+	payload    *texttemplate.Template
+}
+
+func newWebhookNotifier(webhookURL, templatePath string) (*webhookNotifier, error) {
+	format := defaultWebhookFormat
+	if templatePath != "" {
+		data, err := os.ReadFile(templatePath)
+		if err != nil {
+			return nil, fmt.Errorf("웹훅 템플릿 파일을 읽을 수 없어요: %w", err)
+		}
+		format = string(data)
+	}
+
+	tmpl, err := texttemplate.New("webhook").Funcs(texttemplate.FuncMap{
+		"json": func(v any) (string, error) {
+			data, err := json.Marshal(v)
+			return string(data), err
+		},
+	}).Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("웹훅 템플릿 형식이 올바르지 않아요: %w", err)
+	}
+
+	return &webhookNotifier{webhookURL: webhookURL, payload: tmpl}, nil
+}
+
+func (n *webhookNotifier) name() string {
+	return "웹훅"
+}
+
+func (n *webhookNotifier) notify(event notificationEvent) error {
+	data, err := newWebhookPayloadData(event)
+	if err != nil {
+		return err
+	}
+
+	var payload bytes.Buffer
+	if err := n.payload.Execute(&payload, data); err != nil {
+		return fmt.Errorf("웹훅 페이로드 생성 실패: %w", err)
+	}
+	if !json.Valid(payload.Bytes()) {
+		return fmt.Errorf("웹훅 템플릿 결과가 올바른 JSON이 아니에요")
+	}
+	return postJSON(n.webhookURL, payload.Bytes())
+}
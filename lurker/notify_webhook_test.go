@@ -0,0 +1,226 @@
+package lurker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func testReservedEvent() notificationEvent {
+	return notificationEvent{
+		eventType: eventReserved,
+		time:      time.Date(2026, 2, 20, 9, 0, 0, 0, kstLocation),
+		attempt:   3,
+		runMode:   "reserve",
+		trip: tripSummary{
+			deptStation: "수서", arrivalStation: "부산", date: "20260301",
+			deptTime: "08:00", arrivalTime: "11:00", customerType: "unregistered", reserverName: "홍길동",
+		},
+		train:       trainAvailability{trainNumber: "305", deptText: "08:00", arrivalText: "10:40", standard: "예약가능"},
+		reservation: reservationConfirmation{reservationNumber: "1234567", seat: "5호차 12A", trainNumber: "305"},
+	}
+}
+
+// 받은 JSON 본문을 돌려주고 status로 답하는 웹훅 서버
+func startWebhookServer(t *testing.T, status int) (*httptest.Server, <-chan map[string]any) {
+	t.Helper()
+	received := make(chan map[string]any, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("요청 = %s %s, want POST application/json", r.Method, r.Header.Get("Content-Type"))
+		}
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]any
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("본문이 JSON이 아니에요: %s", body)
+		}
+		received <- payload
+		w.WriteHeader(status)
+		io.WriteString(w, "invalid_token")
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func TestWebhookNotifiers(t *testing.T) {
+	redactConfig.enabled = true
+	event := testReservedEvent()
+
+	tests := []struct {
+		name  string
+		build func(url string) notifier
+		field string // 본문이 들어가는 필드 (비어 있으면 기본 웹훅 형식)
+	}{
+		{"Slack", func(url string) notifier { return &slackNotifier{webhookURL: url} }, "text"},
+		{"Discord", func(url string) notifier { return &discordNotifier{webhookURL: url} }, "content"},
+		{"웹훅", func(url string) notifier {
+			n, err := newWebhookNotifier(url, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			return n
+		}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, received := startWebhookServer(t, http.StatusOK)
+			if err := tt.build(server.URL).notify(event); err != nil {
+				t.Fatalf("notify: %v", err)
+			}
+			payload := <-received
+
+			if tt.field == "" {
+				if payload["event"] != "reserved" || payload["attempt"] != float64(3) {
+					t.Errorf("기본 웹훅 형식이 아니에요: %v", payload)
+				}
+				train, _ := payload["train"].(map[string]any)
+				if train["number"] != "305" {
+					t.Errorf("train.number = %v", train["number"])
+				}
+				return
+			}
+			text, _ := payload[tt.field].(string)
+			if !strings.Contains(text, "305") {
+				t.Errorf("%s에 열차 번호가 없어요: %q", tt.field, text)
+			}
+		})
+	}
+
+	t.Run("응답 오류", func(t *testing.T) {
+		server, received := startWebhookServer(t, http.StatusForbidden)
+		err := (&slackNotifier{webhookURL: server.URL}).notify(event)
+		<-received
+		if err == nil || !strings.Contains(err.Error(), "403") || !strings.Contains(err.Error(), "invalid_token") {
+			t.Errorf("err = %v, want 403 응답 오류", err)
+		}
+	})
+}
+
+func TestDiscordMessageLimit(t *testing.T) {
+	dir := t.TempDir()
+	long := strings.Repeat("빈", 3000)
+	source := `{{define "subject"}}제목{{end}}{{define "text"}}` + long + `{{end}}{{define "html"}}{{end}}`
+	if err := os.WriteFile(filepath.Join(dir, "reserved.tmpl"), []byte(source), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	saved := notificationTemplateDir
+	notificationTemplateDir = dir
+	defer func() { notificationTemplateDir = saved }()
+
+	server, received := startWebhookServer(t, http.StatusNoContent)
+	if err := (&discordNotifier{webhookURL: server.URL}).notify(testReservedEvent()); err != nil {
+		t.Fatal(err)
+	}
+	content, _ := (<-received)["content"].(string)
+	if got := utf8.RuneCountInString(content); got != discordContentLimit {
+		t.Errorf("메시지 길이 %d자, want %d자", got, discordContentLimit)
+	}
+	if !strings.HasSuffix(content, "…") {
+		t.Error("잘린 메시지가 …로 끝나지 않아요")
+	}
+}
+
+func TestWebhookTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  string
+	}{
+		{"사용자 템플릿", `{"text": {{json .Subject}}, "train": {{json .Train.Number}}}`, ""},
+		{"JSON이 아닌 결과", `text={{.Subject}}`, "올바른 JSON이 아니에요"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "webhook.tmpl")
+			os.WriteFile(path, []byte(tt.template), 0o600)
+			server, received := startWebhookServer(t, http.StatusOK)
+			n, err := newWebhookNotifier(server.URL, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = n.notify(testReservedEvent())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if payload := <-received; payload["train"] != "305" {
+				t.Errorf("payload = %v", payload)
+			}
+		})
+	}
+
+	if _, err := newWebhookNotifier("http://127.0.0.1", filepath.Join(t.TempDir(), "없음.tmpl")); err == nil {
+		t.Error("없는 템플릿 파일인데 오류가 없어요")
+	}
+}
+
+func TestFailedWebhookURLStaysOffDisk(t *testing.T) {
+	savedStateDir := stateDirOverride
+	stateDirOverride = t.TempDir()
+	defer func() { stateDirOverride = savedStateDir }()
+
+	// 연결이 거부되는 주소 (요청 오류에 URL이 그대로 들어가는 경우)
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	forbidden, _ := startWebhookServer(t, http.StatusForbidden)
+	const secretPath = "/services/T0000/B0000/s3cr3tW3bh00kT0k3n"
+
+	tests := []struct {
+		name     string
+		baseURL  string
+		attempts int // 발송 전 시도 횟수 (마지막 시도면 대체 파일에 기록됨)
+	}{
+		{"연결 실패 후 재시도 대기", closed.URL, 0},
+		{"연결 실패로 대체 파일에 기록", closed.URL, maxNotificationAttempts - 1},
+		{"응답 오류 후 재시도 대기", forbidden.URL, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			s := newSession(context.Background(), Job{ID: "alice", Mode: "reserve"}, out)
+			webhookURL := tt.baseURL + secretPath
+			n := routeNotifier("slack", destinationKey(webhookURL), &slackNotifier{webhookURL: webhookURL})
+
+			item := s.enqueueNotification(n, testReservedEvent())
+			item.Attempts = tt.attempts
+			deliverQueuedNotification(item)
+			defer func() {
+				notificationQueue.Lock()
+				removeQueuedNotificationLocked(item)
+				notificationQueue.Unlock()
+			}()
+
+			if item.LastError == "" {
+				t.Fatal("발송 오류가 기록되지 않았어요")
+			}
+			written := []string{item.LastError, out.String()}
+			filepath.WalkDir(stateDirOverride, func(path string, d os.DirEntry, err error) error {
+				if err == nil && !d.IsDir() {
+					data, _ := os.ReadFile(path)
+					written = append(written, string(data))
+				}
+				return nil
+			})
+			for _, text := range written {
+				if strings.Contains(text, "s3cr3tW3bh00kT0k3n") {
+					t.Errorf("웹훅 URL이 남았어요: %q", text)
+				}
+			}
+		})
+	}
+}
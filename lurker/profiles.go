@@ -0,0 +1,438 @@
+package lurker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 👥 승객 프로필과 경로 프리셋 (profiles.yaml)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 👤 승객 프로필 (비밀번호는 저장하지 않고 보관함 자격 증명 이름으로 참조)
+type passengerProfile struct {
+	Name       string   `yaml:"name"`
+	Phone      string   `yaml:"phone,omitempty"`
+	Credential string   `yaml:"credential,omitempty"` // vault add로 저장한 자격 증명 이름
+	Notify     []string `yaml:"notify,omitempty"`     // 기본 알림 이메일
+}
+
+// 🛤️ 경로 프리셋 (출발/도착역과 기본 출발/도착 시간, 날짜는 실행할 때 입력)
+type routePreset struct {
+	From        string `yaml:"from"`
+	To          string `yaml:"to"`
+	DeptTime    string `yaml:"deptTime"`    // HH:MM
+	ArrivalTime string `yaml:"arrivalTime"` // HH:MM
+}
+
+// 📒 profiles.yaml 파일 구조체
+type profileBook struct {
+	Passengers map[string]passengerProfile `yaml:"passengers"`
+	Routes     map[string]routePreset      `yaml:"routes"`
+}
+
+var (
+	profilePhonePattern = regexp.MustCompile(`^010\d{8}$`)
+	profileTimePattern  = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`)
+)
+
+// 📁 프로필 파일 경로 (PROFILES_FILE 또는 사용자 설정 폴더/srt-lurker/profiles.yaml)
+func profilesPath() string {
+	if path := os.Getenv("PROFILES_FILE"); path != "" {
+		return path
+	}
+	if path := userConfigPath(); path != "" {
+		return filepath.Join(filepath.Dir(path), "profiles.yaml")
+	}
+	return "profiles.yaml"
+}
+
+// 📖 프로필 파일을 읽는 함수 (파일이 없으면 빈 목록)
+func loadProfiles() (profileBook, error) {
+	book := profileBook{
+		Passengers: map[string]passengerProfile{},
+		Routes:     map[string]routePreset{},
+	}
+	data, err := os.ReadFile(profilesPath())
+	if errors.Is(err, fs.ErrNotExist) {
+		return book, nil
+	}
+	if err != nil {
+		return book, fmt.Errorf("프로필 파일을 읽을 수 없어요: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&book); err != nil && !errors.Is(err, io.EOF) {
+		return book, fmt.Errorf("프로필 파일 형식이 올바르지 않아요 (%s): %w", profilesPath(), err)
+	}
+	if book.Passengers == nil {
+		book.Passengers = map[string]passengerProfile{}
+	}
+	if book.Routes == nil {
+		book.Routes = map[string]routePreset{}
+	}
+	return book, nil
+}
+
+// 💾 프로필 파일을 저장하는 함수 (전화번호가 있으므로 현재 사용자만 읽을 수 있게)
+func saveProfiles(book profileBook) error {
+	path := profilesPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("프로필 폴더를 만들 수 없어요: %w", err)
+	}
+	data, err := yaml.Marshal(book)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("프로필 파일을 저장할 수 없어요: %w", err)
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// ✅ 프로필 내용을 점검해 문제를 모두 모으는 함수 (config validate에서도 사용)
+func validateProfiles(book profileBook) []string {
+	var problems []string
+
+	for _, name := range sortedKeys(book.Passengers) {
+		profile := book.Passengers[name]
+		prefix := "profiles.passengers." + name
+		if strings.TrimSpace(profile.Name) == "" {
+			problems = append(problems, prefix+".name 값이 비어 있어요")
+		}
+		if profile.Phone != "" && !profilePhonePattern.MatchString(profile.Phone) {
+			problems = append(problems, prefix+".phone 값은 010으로 시작하는 11자리 숫자여야 해요")
+		}
+		for _, email := range profile.Notify {
+			if !emailFormatPattern.MatchString(email) {
+				problems = append(problems, fmt.Sprintf("%s.notify 값에 잘못된 이메일 주소가 있어요: %s", prefix, email))
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(book.Routes) {
+		route := book.Routes[name]
+		prefix := "profiles.routes." + name
+		if !isSRTStation(route.From) {
+			problems = append(problems, fmt.Sprintf("%s.from 값이 SRT 역이 아니에요: %s", prefix, route.From))
+		}
+		if !isSRTStation(route.To) {
+			problems = append(problems, fmt.Sprintf("%s.to 값이 SRT 역이 아니에요: %s", prefix, route.To))
+		}
+		if route.From != "" && route.From == route.To {
+			problems = append(problems, prefix+"의 출발역과 도착역이 같아요")
+		}
+		if !profileTimePattern.MatchString(route.DeptTime) {
+			problems = append(problems, fmt.Sprintf("%s.deptTime 값은 HH:MM 형식이어야 해요: %s", prefix, route.DeptTime))
+		}
+		if !profileTimePattern.MatchString(route.ArrivalTime) {
+			problems = append(problems, fmt.Sprintf("%s.arrivalTime 값은 HH:MM 형식이어야 해요: %s", prefix, route.ArrivalTime))
+		}
+	}
+	return problems
+}
+
+// 이름순으로 정렬한 맵 키
+func sortedKeys[V any](items map[string]V) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// 👤 승객 프로필을 입력 정보에 반영하는 함수 (예약 모드에서는 로그인 참조나 이름/전화번호 사용)
+func applyPassengerProfile(job *Job, name string, profile passengerProfile) {
+	fmt.Printf("   ✅ '%s' 승객 프로필을 사용할게요 (%s)\n", name, profile.Name)
+	if len(profile.Notify) > 0 {
+		job.NotifyEmails = profile.Notify
+	}
+	if job.Mode != "reserve" {
+		return
+	}
+	if profile.Credential != "" && job.Credential == "" {
+		job.Credential = profile.Credential
+		return
+	}
+	if profile.Credential == "" && job.Credential == "" {
+		job.CustomerType = "unregistered"
+		job.Name = profile.Name
+		job.Phone = profile.Phone
+	}
+}
+
+// 🛤️ 경로 프리셋을 입력 정보에 반영하는 함수
+func applyRoutePreset(job *Job, name string, route routePreset) {
+	job.From = route.From
+	job.To = route.To
+	job.DeptTime = route.DeptTime
+	job.ArrivalTime = route.ArrivalTime
+	fmt.Printf("   ✅ '%s' 경로를 사용할게요: %s(%s) → %s(%s)\n",
+		name, route.From, route.DeptTime, route.To, route.ArrivalTime)
+}
+
+// 🧩 프로필과 명령행으로 미리 정한 값을 어떻게 썼는지 (입력 마법사가 건너뛸 항목 판단용)
+type jobPresets struct {
+	profileCredential bool // 승객 프로필의 보관함 참조를 쓰는지 (다시 입력할 때 되돌림)
+	profileDetails    bool // 승객 프로필의 이름/전화번호로 미등록 고객 예매를 하는지
+	profileNotify     bool // 승객 프로필의 기본 알림 주소를 쓰는지
+}
+
+// 🧾 작업에 쓸 프로필 이름과 직접 정한 여정 값 (명령행 플래그나 작업 파일의 한 항목)
+type jobSpec struct {
+	passenger   string
+	route       string
+	credential  string
+	from        string
+	to          string
+	date        string // YYYYMMDD 또는 YYYY-MM-DD
+	deptTime    string // HHMM 또는 HH:MM
+	arrivalTime string
+	priority    int // 0이면 작업에 이미 정한 값 유지
+}
+
+// 🧩 --passenger/--route 프로필과 --from/--to/--date/--dept-time/--arrival-time 값을 입력 정보에 반영하는 함수
+// 프로필보다 명령행에 직접 적은 값이 우선이에요
+func applyJobPresets(job *Job, profiles profileBook) (jobPresets, error) {
+	return applyJobSpec(job, profiles, jobSpec{
+		passenger:   runOptions.passenger,
+		route:       runOptions.route,
+		credential:  runOptions.credential,
+		from:        runOptions.from,
+		to:          runOptions.to,
+		date:        runOptions.date,
+		deptTime:    runOptions.deptTime,
+		arrivalTime: runOptions.arrivalTime,
+		priority:    runOptions.priority,
+	})
+}
+
+// 🧩 프로필과 직접 정한 여정 값을 입력 정보에 반영하는 함수 (직접 정한 값이 프로필보다 우선)
+func applyJobSpec(job *Job, profiles profileBook, spec jobSpec) (jobPresets, error) {
+	var presets jobPresets
+	if job.Credential == "" {
+		job.Credential = spec.credential
+	}
+	if spec.priority != 0 {
+		job.Priority = spec.priority
+	}
+	if name := spec.passenger; name != "" {
+		profile, ok := profiles.Passengers[name]
+		if !ok {
+			return presets, fmt.Errorf("'%s' 승객 프로필이 없어요 ('profile list'로 확인해주세요)", name)
+		}
+		presets.profileCredential = profile.Credential != "" && job.Credential == ""
+		presets.profileDetails = profile.Credential == "" && job.Credential == ""
+		presets.profileNotify = len(profile.Notify) > 0
+		applyPassengerProfile(job, name, profile)
+	}
+	if name := spec.route; name != "" {
+		route, ok := profiles.Routes[name]
+		if !ok {
+			return presets, fmt.Errorf("'%s' 경로 프리셋이 없어요 ('profile list'로 확인해주세요)", name)
+		}
+		applyRoutePreset(job, name, route)
+	}
+
+	for _, station := range []struct{ flag, value string }{{"--from", spec.from}, {"--to", spec.to}} {
+		if station.value != "" && !isSRTStation(station.value) {
+			return presets, fmt.Errorf("%s 값이 SRT 역이 아니에요: %s ('stations'로 역 목록을 확인해주세요)", station.flag, station.value)
+		}
+	}
+	if spec.from != "" {
+		job.From = spec.from
+	}
+	if spec.to != "" {
+		job.To = spec.to
+	}
+	if spec.date != "" {
+		date, err := normalizeDate(spec.date)
+		if err != nil {
+			return presets, err
+		}
+		job.Date = date
+	}
+	if spec.deptTime != "" {
+		deptTime, err := normalizeClock("--dept-time", spec.deptTime)
+		if err != nil {
+			return presets, err
+		}
+		job.DeptTime = deptTime
+	}
+	if spec.arrivalTime != "" {
+		arrivalTime, err := normalizeClock("--arrival-time", spec.arrivalTime)
+		if err != nil {
+			return presets, err
+		}
+		job.ArrivalTime = arrivalTime
+	}
+	return presets, nil
+}
+
+// 🔢 저장된 프로필 중 하나를 고르는 함수 (0 또는 엔터: 직접 입력, 빈 문자열 반환)
+func chooseProfile(title string, names []string, describe func(string) string) string {
+	printSubHeader(title)
+	fmt.Println("   0. 직접 입력")
+	for i, name := range names {
+		fmt.Printf("   %d. %s — %s\n", i+1, name, describe(name))
+	}
+	fmt.Println()
+
+	for {
+		choice := getUserInput(fmt.Sprintf("번호를 선택하세요 (0~%d)", len(names)), "0")
+		index, err := strconv.Atoi(choice)
+		if err == nil && index >= 0 && index <= len(names) {
+			if index == 0 {
+				return ""
+			}
+			return names[index-1]
+		}
+		fmt.Printf("   ❌ 0부터 %d 사이의 번호를 입력해주세요\n", len(names))
+	}
+}
+
+// 👥 profile 명령을 처리하는 함수 (종료 코드 반환)
+func runProfileCommand(args []string) int {
+	usage := "사용법: profile list | profile add-passenger <이름> | profile add-route <이름> | profile remove <passenger|route> <이름>"
+	if len(args) == 0 {
+		fmt.Println(usage)
+		return exitUsage
+	}
+
+	book, err := loadProfiles()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitFailure
+	}
+
+	printHeader("👥 승객 프로필과 경로 프리셋")
+	fmt.Printf("   📁 %s\n", profilesPath())
+
+	switch {
+	case args[0] == "list":
+		if len(book.Passengers) == 0 && len(book.Routes) == 0 {
+			fmt.Println("   ℹ️ 저장된 프로필이 없어요")
+			return exitOK
+		}
+		fmt.Println("   👤 승객 프로필:")
+		for _, name := range sortedKeys(book.Passengers) {
+			fmt.Printf("   • %s: %s\n", name, describePassenger(book.Passengers[name]))
+		}
+		fmt.Println("   🛤️ 경로 프리셋:")
+		for _, name := range sortedKeys(book.Routes) {
+			fmt.Printf("   • %s: %s\n", name, describeRoute(book.Routes[name]))
+		}
+		if problems := validateProfiles(book); len(problems) > 0 {
+			printConfigProblems(problems)
+			return exitFailure
+		}
+		return exitOK
+
+	case args[0] == "add-passenger" && len(args) == 2:
+		name := args[1]
+		if _, ok := book.Passengers[name]; ok && !getYesNoInput(fmt.Sprintf("'%s' 승객 프로필이 이미 있어요. 덮어쓸까요?", name), false) {
+			return exitFailure
+		}
+		book.Passengers[name] = promptPassengerProfile()
+
+	case args[0] == "add-route" && len(args) == 2:
+		name := args[1]
+		if _, ok := book.Routes[name]; ok && !getYesNoInput(fmt.Sprintf("'%s' 경로 프리셋이 이미 있어요. 덮어쓸까요?", name), false) {
+			return exitFailure
+		}
+		book.Routes[name] = promptRoutePreset()
+
+	case args[0] == "remove" && len(args) == 3:
+		kind, name := args[1], args[2]
+		switch kind {
+		case "passenger":
+			if _, ok := book.Passengers[name]; !ok {
+				fmt.Printf("   ❌ '%s' 승객 프로필이 없어요\n", name)
+				return exitFailure
+			}
+			delete(book.Passengers, name)
+		case "route":
+			if _, ok := book.Routes[name]; !ok {
+				fmt.Printf("   ❌ '%s' 경로 프리셋이 없어요\n", name)
+				return exitFailure
+			}
+			delete(book.Routes, name)
+		default:
+			fmt.Println(usage)
+			return exitUsage
+		}
+
+	default:
+		fmt.Println(usage)
+		return exitUsage
+	}
+
+	if err := saveProfiles(book); err != nil {
+		fmt.Printf("   ❌ %v\n", err)
+		return exitFailure
+	}
+	fmt.Println("   ✅ 프로필을 저장했어요")
+	return exitOK
+}
+
+// 승객 프로필 한 줄 요약 (전화번호와 주소는 가림)
+func describePassenger(profile passengerProfile) string {
+	parts := []string{profile.Name}
+	if profile.Phone != "" {
+		parts = append(parts, redact(profile.Phone))
+	}
+	if profile.Credential != "" {
+		parts = append(parts, "자격 증명 "+profile.Credential)
+	}
+	if len(profile.Notify) > 0 {
+		parts = append(parts, "알림 "+redact(strings.Join(profile.Notify, ", ")))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// 경로 프리셋 한 줄 요약
+func describeRoute(route routePreset) string {
+	return fmt.Sprintf("%s(%s) → %s(%s)", route.From, route.DeptTime, route.To, route.ArrivalTime)
+}
+
+// 승객 프로필 하나를 입력받는 함수
+func promptPassengerProfile() passengerProfile {
+	var profile passengerProfile
+	profile.Name = getInputWithValidation("승객 이름을 입력하세요", "",
+		func(s string) bool { return validateRequired(s, "승객 이름") }, "홍길동")
+	profile.Phone = getInputWithValidation("전화번호를 입력하세요 (숫자만, 엔터: 생략)", "",
+		func(s string) bool { return s == "" || validatePhone(s) }, "01012345678")
+	fmt.Println("   ℹ️ 로그인 정보와 비밀번호는 보관함(vault add)에 저장하고 여기서는 이름으로만 참조해요")
+	profile.Credential = getUserInput("보관함 자격 증명 이름 (엔터: 없음)", "")
+	notify := getInputWithValidation("기본 알림 이메일 (여러 개는 쉼표로 구분, 엔터: 없음)", "",
+		validateEmailList, "me@gmail.com")
+	profile.Notify = splitList(notify)
+	return profile
+}
+
+// 경로 프리셋 하나를 입력받는 함수
+func promptRoutePreset() routePreset {
+	var route routePreset
+	route.From = selectStation("출발역을 선택하세요")
+	route.To = selectStation("도착역을 선택하세요")
+	fmt.Printf("   ✅ %s → %s\n", route.From, route.To)
+	deptTime := getInputWithValidation("기본 출발시간을 입력하세요 (4자리 숫자)", "", validateTime, "0730")
+	arrivalTime := getInputWithValidation("기본 도착시간을 입력하세요 (4자리 숫자)", "", validateTime, "1002")
+	route.DeptTime = deptTime[:2] + ":" + deptTime[2:]
+	route.ArrivalTime = arrivalTime[:2] + ":" + arrivalTime[2:]
+	return route
+}
@@ -0,0 +1,168 @@
+package lurker
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🙈 개인정보 가리기 (콘솔, 아티팩트, 알림)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+var (
+	phoneNumberPattern  = regexp.MustCompile(`\b(01[016789])([-. ]?)(\d{3,4})([-. ]?)(\d{4})\b`)
+	emailAddressPattern = regexp.MustCompile(`\b([A-Za-z0-9])[A-Za-z0-9._%+\-]*@([A-Za-z0-9.\-]+\.[A-Za-z]{2,})`)
+)
+
+// 🙈 문자열에서 전화번호, 이메일, 회원번호, 비밀번호를 가리는 함수 (REDACT_PII=false면 그대로)
+// 화면/파일/알림으로 나가는 곳에서 호출해요
+func redact(text string) string {
+	if !redactConfig.enabled || text == "" {
+		return text
+	}
+
+	// 입력받은 비밀 값은 형식과 관계없이 가림
+	for _, secret := range []string{emailConfig.senderPass, telegramConfig.botToken} {
+		text = redactValue(text, secret, "****")
+	}
+	jobSecrets.Lock()
+	for value, replacement := range jobSecrets.values {
+		text = redactValue(text, value, replacement)
+	}
+	jobSecrets.Unlock()
+
+	text = phoneNumberPattern.ReplaceAllString(text, "$1$2****$4$5")
+	text = emailAddressPattern.ReplaceAllString(text, "$1***@$2")
+	return text
+}
+
+// 🙈 쓰는 내용마다 개인정보를 가린 뒤 넘기는 Writer
+// 작업 출력, jobs 콘솔, 작업 로그 파일이 모두 이걸 거쳐서 출력하는 곳마다 redact를 부르지 않아도 돼요
+type redactingWriter struct {
+	w io.Writer
+}
+
+func (r redactingWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(r.w, redact(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// 이미 가리는 Writer면 그대로 돌려주는 함수
+func newRedactingWriter(w io.Writer) io.Writer {
+	if _, ok := w.(redactingWriter); ok {
+		return w
+	}
+	return redactingWriter{w: w}
+}
+
+// 🔑 실행 중인 작업들의 비밀 값 (값 → 대신 보여줄 문자열)
+// 작업마다 따로 가리지 않고 모두 모아서, 어느 작업의 출력이든 다른 작업의 비밀번호도 가려요
+var jobSecrets = struct {
+	sync.Mutex
+	values map[string]string
+}{values: map[string]string{}}
+
+// 작업의 비밀번호와 회원번호를 가릴 값으로 등록하는 함수
+func registerJobSecrets(job Job) {
+	jobSecrets.Lock()
+	defer jobSecrets.Unlock()
+	for _, secret := range []string{job.Password, job.LoginPassword} {
+		if secret != "" {
+			jobSecrets.values[secret] = "****"
+		}
+	}
+	if job.LoginType == "member" && job.LoginID != "" {
+		jobSecrets.values[job.LoginID] = maskMiddle(job.LoginID)
+	}
+}
+
+// 값이 들어 있는 곳을 바꾸는 함수 (숫자로만 된 값은 더 긴 숫자의 일부일 때는 건드리지 않음)
+func redactValue(text, value, replacement string) string {
+	if len(value) < 4 {
+		return text
+	}
+	if strings.Trim(value, "0123456789") != "" {
+		return strings.ReplaceAll(text, value, replacement)
+	}
+
+	var buf strings.Builder
+	for {
+		index := strings.Index(text, value)
+		if index < 0 {
+			buf.WriteString(text)
+			return buf.String()
+		}
+		end := index + len(value)
+		standalone := (index == 0 || !isASCIIDigit(text[index-1])) && (end == len(text) || !isASCIIDigit(text[end]))
+		buf.WriteString(text[:index])
+		if standalone {
+			buf.WriteString(replacement)
+		} else {
+			buf.WriteString(value)
+		}
+		text = text[end:]
+	}
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// 로그인 ID를 유형에 맞게 가리는 함수 (회원번호는 가운데, 이메일/전화번호는 형식대로)
+func redactLoginID(loginType, loginID string) string {
+	if !redactConfig.enabled {
+		return loginID
+	}
+	if loginType == "member" {
+		return maskMiddle(loginID)
+	}
+	return redact(loginID)
+}
+
+// 앞 2자리와 뒤 2자리만 남기고 가리는 함수 (예: 1234567890 → 12******90)
+func maskMiddle(value string) string {
+	if len(value) <= 4 {
+		return strings.Repeat("*", len(value))
+	}
+	return value[:2] + strings.Repeat("*", len(value)-4) + value[len(value)-2:]
+}
+
+// 📁 실행 간에 유지할 상태 파일을 두는 폴더 (STATE_DIR 또는 사용자 설정 폴더/srt-lurker)
+func stateDir() string {
+	dir := stateDirOverride
+	if dir == "" {
+		if configDir, err := os.UserConfigDir(); err == nil {
+			dir = filepath.Join(configDir, "srt-lurker")
+		} else {
+			dir = ".srt-lurker"
+		}
+	}
+	// 만들지 못하면 그 안에 파일을 쓸 때 오류로 알려줘요
+	os.MkdirAll(dir, 0o700)
+	return dir
+}
+
+// 쉼표로 구분한 목록을 공백을 제거해 나누는 함수 (빈 항목은 제외)
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func safeAction(action func() error, errorMsg string) error {
+	if err := action(); err != nil {
+		return fmt.Errorf("%s: %w", errorMsg, err)
+	}
+	return nil
+}
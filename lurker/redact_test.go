@@ -0,0 +1,84 @@
+package lurker
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	redactConfig.enabled = true
+	registerJobSecrets(Job{Password: "48213", LoginType: "member", LoginID: "1234567890"})
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"빈 문자열", "", ""},
+		{"하이픈 전화번호", "연락처 010-1234-5678", "연락처 010-****-5678"},
+		{"붙여 쓴 전화번호", "01012345678로 보냈어요", "010****5678로 보냈어요"},
+		{"이메일", "수신: me@example.com", "수신: m***@example.com"},
+		{"예약 비밀번호", "비밀번호 48213 입력", "비밀번호 **** 입력"},
+		{"회원번호", "회원 1234567890 로그인", "회원 12******90 로그인"},
+		{"더 긴 숫자의 일부는 그대로", "예약번호 9912345678901", "예약번호 9912345678901"},
+		{"가릴 것이 없음", "수서 → 부산 08:00", "수서 → 부산 08:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redact(tt.in); got != tt.want {
+				t.Errorf("redact(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactDisabled(t *testing.T) {
+	redactConfig.enabled = false
+	defer func() { redactConfig.enabled = true }()
+
+	if got := redact("010-1234-5678"); got != "010-1234-5678" {
+		t.Errorf("REDACT_PII=false인데 가렸어요: %q", got)
+	}
+}
+
+func TestRedactValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		value string
+		want  string
+	}{
+		{"짧은 값은 가리지 않음", "pin 123", "123", "pin 123"},
+		{"문자가 섞인 값은 어디서든 가림", "pw=hunter22!x", "hunter22", "pw=****!x"},
+		{"숫자 값이 단독이면 가림", "code 4821 ok", "4821", "code **** ok"},
+		{"숫자 값이 더 긴 숫자의 일부면 그대로", "id 148210", "4821", "id 148210"},
+		{"여러 번 나오면 단독인 것만", "4821/94821/4821", "4821", "****/94821/****"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactValue(tt.text, tt.value, "****"); got != tt.want {
+				t.Errorf("redactValue(%q, %q) = %q, want %q", tt.text, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactingWriter(t *testing.T) {
+	redactConfig.enabled = true
+
+	var buf bytes.Buffer
+	w := newRedactingWriter(&buf)
+	if newRedactingWriter(w) != w {
+		t.Error("이미 가리는 Writer를 한 번 더 감쌌어요")
+	}
+	n, err := w.Write([]byte("문의 010-9876-5432\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len("문의 010-9876-5432\n") {
+		t.Errorf("쓴 바이트 수 = %d, 원래 길이를 돌려줘야 해요", n)
+	}
+	if got, want := buf.String(), "문의 010-****-5432\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,360 @@
+package lurker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🚄 예약/감시 작업 실행
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🧳 실행 중인 작업 하나의 상태 (작업마다 따로 가지므로 한 프로세스에서 여러 작업을 돌릴 수 있어요)
+type session struct {
+	job    Job
+	dryRun bool            // 최종 예약 확정 직전에 멈추고 결과만 보고
+	out    io.Writer       // 진행 메시지를 쓰는 곳
+	ctx    context.Context // 취소되면 진행 중인 시도를 멈추고 정리해요
+
+	selectedTrain        trainAvailability       // 5단계에서 예약하기를 누른 열차 (결과 보고용)
+	confirmedReservation reservationConfirmation // 예약 완료 화면에서 읽은 확인 정보 (예약 성공 알림, 캘린더용)
+	notifiers            []routedNotifier        // 이번 작업에서 알림을 보낼 채널 목록
+	control              runControl
+	stats                huntStats
+	dialogs              dialogLog
+	traceChunkActive     bool             // 진행 중인 trace 청크가 있는지 여부
+	traceDisabled        bool             // trace 기록을 시작하지 못해 이 작업에서는 끈 상태
+	hunt                 *huntCoordinator // 같은 열차를 노리는 다른 작업과의 조율 상태 (조율하지 않으면 nil)
+	shared               bool             // 다른 작업과 함께 실행 중인지 (jobs 명령, 프로세스 하나에 하나뿐인 자원은 쓰지 않음)
+	done                 chan struct{}    // 작업이 끝나면 닫힘 (텔레그램 명령 수신 등 작업별 고루틴 종료용)
+}
+
+func newSession(ctx context.Context, job Job, out io.Writer) *session {
+	s := &session{job: job, out: newRedactingWriter(out), ctx: ctx, done: make(chan struct{})}
+	s.control.startedAt = time.Now()
+	s.stats.periodStart = time.Now()
+	s.stats.outcomes = map[string]int{}
+	s.stats.trainsSeen = map[string]trainAvailability{}
+	registerJobSecrets(job)
+	return s
+}
+
+func (s *session) printf(format string, args ...any) {
+	fmt.Fprintf(s.out, format, args...)
+}
+
+func (s *session) println(args ...any) {
+	fmt.Fprintln(s.out, args...)
+}
+
+// 작업이 끝났는지 (runJob이 돌아왔거나 취소됨)
+func (s *session) finished() bool {
+	select {
+	case <-s.done:
+		return true
+	case <-s.ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// ⏱️ 정해진 시간만큼 기다리는 함수 (작업이 취소되면 바로 false를 돌려줌)
+func (s *session) sleep(duration time.Duration) bool {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
+
+// 🚄 입력 마법사로 빠진 정보를 채운 뒤 작업을 실행하는 함수 (run, watch, 명령 없이 실행)
+func runInteractiveJob() int {
+	var job Job
+	collectUserInput(&job)
+	return runCLIJob(job)
+}
+
+// 🚄 명령행에서 작업 하나를 실행하고 결과를 종료 코드로 바꾸는 함수 (Ctrl+C는 작업 취소로 전달)
+func runCLIJob(job Job) int {
+	return runCLIJobWith(job, nil)
+}
+
+// 🚄 실행 중인 작업을 ready로 넘겨준 뒤 끝날 때까지 기다리는 함수 (serve 제어 API 연결용)
+func runCLIJobWith(job Job, ready func(*session)) int {
+	ctx, stop := signalContext()
+	defer stop()
+
+	result, err := run(ctx, job, Options{DryRun: runOptions.dryRun, VaultPassphrase: promptVaultPassphrase}, ready)
+	switch {
+	case result.Outcome == "interrupted":
+		return exitInterrupted
+	case err != nil && result.Outcome == "":
+		fmt.Printf("❌ %v\n", err)
+		return exitFailure
+	case err != nil:
+		return exitFailure
+	}
+	return exitOK
+}
+
+// 🌐 작업을 실행하는 동안 쓸 브라우저를 띄우고 작업이 끝나면 닫는 함수
+func run(ctx context.Context, job Job, opts Options, ready func(*session)) (Result, error) {
+	if opts.Output == nil {
+		opts.Output = os.Stdout
+	}
+	if needsVaultCredential(job) {
+		if err := applyVaultCredential(&job, job.Credential, opts.VaultPassphrase, newRedactingWriter(opts.Output)); err != nil {
+			return Result{}, err
+		}
+	}
+	if problems := normalizeJob(&job); len(problems) > 0 {
+		return Result{}, fmt.Errorf("작업 정보가 올바르지 않아요: %s", strings.Join(problems, "; "))
+	}
+
+	s := newSession(ctx, job, opts.Output)
+	s.dryRun = opts.DryRun
+	if ready != nil {
+		ready(s)
+	}
+
+	pw, browser, err := launchBrowser(browserConfig.headless)
+	if err != nil {
+		return Result{}, err
+	}
+	defer pw.Stop()
+	defer browser.Close()
+
+	return s.runJob(browser)
+}
+
+// 보관함 자격 증명은 아직 채우지 않았을 때만 읽음 (입력 마법사가 이미 채웠을 수 있음)
+func needsVaultCredential(job Job) bool {
+	return job.Credential != "" && job.LoginID == "" && job.Password == "" && firstNonEmpty(job.Mode, "reserve") == "reserve"
+}
+
+// 🚄 작업의 여정으로 예약 시도나 빈자리 감시를 실행하는 함수
+// 실행 결과는 실행 기록(history)에도 남기고, 실패로 끝나면 마지막 오류를 돌려줘요
+func (s *session) runJob(browser playwright.Browser) (result Result, err error) {
+	defer close(s.done)
+	defer func() {
+		if r := recover(); r != nil {
+			s.println("\n⚠️ 치명적 오류 발생!")
+			s.printf("오류 내용: %v\n", r)
+			result.Outcome = "failed"
+			err = fmt.Errorf("치명적 오류: %v", r)
+		}
+	}()
+
+	s.println("▶ SRT 예약 자동화 시작...")
+	s.printf("최대 %d회까지 재시도해요\n", retryConfig.maxAttempts)
+	if s.dryRun {
+		s.println("🧪 드라이런 모드: 최종 예약 확정 직전에 멈춰요")
+	}
+	s.println(strings.Repeat("=", 60))
+
+	s.control.startedAt = time.Now()
+	s.setupNotifiers()
+	defer s.stopNotificationQueue()
+
+	browserContext, page, err := s.openPage(browser)
+	if err != nil {
+		return Result{}, err
+	}
+	defer browserContext.Close()
+	s.showLoadingAnimation("시스템을 준비하는 중이에요", 1)
+
+	s.joinHunt()
+	defer s.leaveHunt()
+
+	// ⛔ 작업이 취소되면 (Ctrl+C 등) 진행 중인 시도를 끊고 아래에서 중단 알림을 보낸 뒤 정리
+	go func() {
+		select {
+		case <-s.ctx.Done():
+			s.printf("\n⛔ 실행을 중단할게요 (%v)\n", context.Cause(s.ctx))
+			s.requestStop()
+			browserContext.Close()
+		case <-s.done:
+		}
+	}()
+
+	// 🩺 사전 점검: 사이트 구조가 바뀌었으면 999번 실패하기 전에 바로 중단
+	if err := s.runHealthCheck(page, s.job.From, s.job.To, s.job.Date); err != nil {
+		if s.ctx.Err() != nil {
+			return s.finishInterrupted(0), s.ctx.Err()
+		}
+		s.printf("\n❌ %v\n", err)
+		s.println("↻ 셀렉터 프로필(--selectors)을 최신 화면에 맞게 수정한 뒤 다시 실행해주세요")
+		s.saveArtifacts(page, "preflight-failed", err)
+		s.dispatchNotification(s.newNotificationEvent(eventAborted, 0, "사전 점검 실패: "+err.Error()))
+		s.recordRunHistory("preflight-failed", 0, err)
+		s.flushNotifications()
+		return Result{Outcome: "preflight-failed"}, err
+	}
+	if _, err := page.Goto(initialURL); err != nil {
+		if s.ctx.Err() != nil {
+			return s.finishInterrupted(0), s.ctx.Err()
+		}
+		return Result{}, fmt.Errorf("페이지 이동 실패: %w", err)
+	}
+
+	if s.job.Mode == "watch" {
+		s.printf("👀 %d초 간격으로 빈자리를 감시해요 (종료: Ctrl+C)\n", retryConfig.watchInterval)
+		s.dispatchNotification(s.newNotificationEvent(eventStarted, 0, "빈자리 감시를 시작했어요"))
+		s.runWatchMode(page)
+
+		attempts := s.attemptCount()
+		if s.ctx.Err() != nil {
+			return s.finishInterrupted(attempts), s.ctx.Err()
+		}
+		s.println("\n⛔ 원격 명령으로 감시를 중지했어요")
+		s.dispatchNotification(s.newNotificationEvent(eventAborted, attempts, "원격 명령으로 감시를 중지했어요"))
+		s.recordRunHistory("stopped", attempts, nil)
+		s.flushNotifications()
+		return s.result("stopped", attempts), nil
+	}
+
+	s.dispatchNotification(s.newNotificationEvent(eventStarted, 0, "예약 시도를 시작했어요"))
+
+	var lastError error
+	outcome := "failed"
+	attempts := 0
+	for attempt := 1; attempt <= retryConfig.maxAttempts; attempt++ {
+		s.waitWhilePaused()
+		s.waitForTurn()
+		if s.ctx.Err() != nil {
+			return s.finishInterrupted(attempts), s.ctx.Err()
+		}
+		if s.stopRequested() {
+			s.println("\n⛔ 원격 명령으로 예약 시도를 중지했어요")
+			s.dispatchNotification(s.newNotificationEvent(eventAborted, attempt-1, "원격 명령으로 예약 시도를 중지했어요"))
+			lastError = nil
+			outcome = "stopped"
+			break
+		}
+		s.updateRunStatus(attempt, "")
+		attempts = attempt
+
+		s.beginAttemptTrace(page)
+		err := s.attemptReservation(page, attempt)
+		if s.ctx.Err() != nil {
+			return s.finishInterrupted(attempts), s.ctx.Err()
+		}
+		s.recordAttemptOutcome(err, "성공")
+		switch {
+		case err != nil:
+			s.saveArtifacts(page, fmt.Sprintf("attempt-%04d-failed", attempt), err)
+		case s.dryRun:
+			s.saveArtifacts(page, fmt.Sprintf("attempt-%04d-dryrun", attempt), nil)
+		default:
+			s.saveArtifacts(page, fmt.Sprintf("attempt-%04d-success", attempt), nil)
+		}
+
+		if err == nil && s.dryRun {
+			s.leaveHunt()
+			s.printDryRunReport(attempt)
+			lastError = nil
+			outcome = "dry-run"
+			break
+		}
+		if err == nil {
+			// 결제를 기다리는 동안 조회를 계속 맡고 있지 않도록 결과가 나오면 바로 조율에서 빠짐
+			s.leaveHunt()
+			s.printf("\n✨ 성공! %d번째 시도에서 예약에 성공했어요!\n", attempt)
+			s.println("ℹ️ 지금 결제를 진행하세요. 10분 후 브라우저가 자동으로 종료돼요")
+
+			lastError = nil
+			outcome = "reserved"
+			s.confirmedReservation = s.readReservationConfirmation(page)
+			s.printReservationConfirmation(s.confirmedReservation)
+			reservedEvent := s.newNotificationEvent(eventReserved, attempt, "")
+			s.saveTripCalendar(reservedEvent)
+			s.dispatchNotification(reservedEvent)
+			s.recordRunHistory(outcome, attempt, nil)
+
+			// 성공 시 카운트다운 표시 (작업이 취소되면 바로 정리)
+			s.println()
+			for i := 600; i > 0; i-- {
+				minutes := i / 60
+				seconds := i % 60
+				s.printf("\r   ⏰ 자동 종료까지: %02d:%02d (결제를 완료해주세요)", minutes, seconds)
+				if !s.sleep(1 * time.Second) {
+					break
+				}
+			}
+			s.println()
+			break
+		}
+
+		lastError = err
+		s.printf("✗ 시도 %d 실패: %v\n", attempt, err)
+		s.updateRunStatus(attempt, err.Error())
+
+		if retryConfig.milestoneEvery > 0 && attempt%retryConfig.milestoneEvery == 0 {
+			s.dispatchNotification(s.newNotificationEvent(eventAttemptMilestone, attempt,
+				fmt.Sprintf("%d번째 시도까지 예약하지 못했어요. 마지막 오류: %v", attempt, err)))
+		}
+		s.maybeSendDigest()
+
+		if attempt < retryConfig.maxAttempts {
+			waitTime := retryConfig.delay
+			s.printf("⏸️ %d초 후 재시도해요...\n", waitTime)
+			s.showLoadingAnimation("다음 시도를 준비하는 중이에요", waitTime)
+		}
+	}
+
+	s.leaveHunt()
+	if lastError != nil {
+		s.printf("\n⚠️ %d회 모든 시도가 실패했어요!\n", retryConfig.maxAttempts)
+		s.printf("마지막 오류: %v\n", lastError)
+		s.println("↻ 프로그램을 다시 실행해보거나 수동으로 예약을 시도해보세요")
+		s.sleep(5 * time.Second)
+
+		s.dispatchNotification(s.newNotificationEvent(eventFailed, retryConfig.maxAttempts, lastError.Error()))
+	}
+	if outcome != "reserved" {
+		s.recordRunHistory(outcome, attempts, lastError)
+	}
+
+	s.flushNotifications()
+	s.println("   ✓ 리소스 정리 완료")
+	return s.result(outcome, attempts), lastError
+}
+
+// ⛔ 취소된 작업의 중단 알림과 실행 기록을 남기는 함수
+func (s *session) finishInterrupted(attempts int) Result {
+	s.dispatchNotification(s.newNotificationEvent(eventAborted, attempts, "사용자가 실행을 중단했어요"))
+	s.recordRunHistory("interrupted", attempts, nil)
+	s.flushNotifications()
+	return s.result("interrupted", attempts)
+}
+
+func (s *session) attemptCount() int {
+	s.control.Lock()
+	defer s.control.Unlock()
+	return s.control.attempt
+}
+
+func (s *session) result(outcome string, attempts int) Result {
+	result := Result{
+		Outcome:           outcome,
+		Attempts:          attempts,
+		TrainNumber:       s.selectedTrain.trainNumber,
+		ReservationNumber: s.confirmedReservation.reservationNumber,
+		Seat:              s.confirmedReservation.seat,
+		PaymentDeadline:   s.confirmedReservation.paymentDeadline,
+	}
+	if s.confirmedReservation.trainNumber != "" {
+		result.TrainNumber = s.confirmedReservation.trainNumber
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package lurker
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🔎 열차 조회 (search)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🔎 여정의 열차와 좌석 상태를 한 번 조회해 출력하는 함수 (예약하지 않음, 입력을 묻지 않음)
+func runSearchCommand() int {
+	profiles, err := loadProfiles()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitFailure
+	}
+	job := Job{Mode: "watch"}
+	if _, err := applyJobPresets(&job, profiles); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitUsage
+	}
+	if job.From == "" || job.To == "" || job.Date == "" {
+		fmt.Println("❌ 출발역, 도착역, 날짜가 필요해요 (--from, --to, --date 또는 --route와 --date)")
+		return exitUsage
+	}
+
+	pw, browser, err := launchBrowser(true)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitFailure
+	}
+	defer pw.Stop()
+	defer browser.Close()
+
+	ctx, stop := signalContext()
+	defer stop()
+	s := newSession(ctx, job, os.Stdout)
+	_, page, err := s.openPage(browser)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitFailure
+	}
+
+	trains, err := s.pollAvailability(page, 1)
+	if err != nil {
+		fmt.Printf("❌ 조회 실패: %s\n", redact(err.Error()))
+		s.saveArtifacts(page, "search-failed", err)
+		return exitFailure
+	}
+
+	fmt.Printf("\n🔎 %s → %s, %s\n", job.From, job.To, job.Date)
+	fmt.Println(strings.Repeat("=", 50))
+	for _, train := range trains {
+		fmt.Printf("   🚆 %s열차 %s → %s | 특실: %s | 일반실: %s\n",
+			train.trainNumber, train.deptText, train.arrivalText, train.premium, train.standard)
+	}
+	return exitOK
+}
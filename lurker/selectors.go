@@ -0,0 +1,117 @@
+package lurker
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🧭 셀렉터 레지스트리 (기본값 내장, 실행 시 외부 파일로 교체 가능)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 지원하는 셀렉터 프로필 파일 버전
+const selectorProfileVersion = 3
+
+//go:embed selectors.json
+var defaultSelectorProfile []byte
+
+// 🧭 셀렉터 프로필 구조체 (selectors.json)
+type selectorProfile struct {
+	Version   int               `json:"version"`
+	Selectors map[string]string `json:"selectors"`
+	Columns   map[string]int    `json:"columns"`       // 조회 결과 표의 td 인덱스
+	Headers   map[string]string `json:"columnHeaders"` // 각 열의 표 제목 (구조 변경 감지용)
+}
+
+// 프로필 파일에 반드시 있어야 하는 셀렉터 키
+var requiredSelectorKeys = []string{
+	"dptStation", "arvStation", "date", "searchButton", "netfunnel",
+	"tableHeaders", "trainRows", "reserveButton", "soldOut",
+	"unregisteredReserveButton", "passengerAgree", "passengerName",
+	"loginTypeMember", "loginTypeEmail", "loginTypePhone",
+	"loginIdMember", "loginIdEmail", "loginIdPhone",
+	"loginPasswordMember", "loginPasswordEmail", "loginPasswordPhone",
+	"loginSubmitMember", "loginSubmitEmail", "loginSubmitPhone",
+	"laterChangeLink", "reservationConfirmation",
+}
+
+// 프로필 파일에 반드시 있어야 하는 표 열 키
+var requiredColumnKeys = []string{"trainNumber", "departure", "arrival", "premium", "standard"}
+
+var selectorRegistry selectorProfile
+
+// 📥 셀렉터 프로필을 불러오는 함수 (path가 비어 있으면 내장 기본값 사용)
+func loadSelectorProfile(path string, out io.Writer) error {
+	data := defaultSelectorProfile
+	source := "내장 기본값"
+	if path != "" {
+		fileData, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("셀렉터 프로필 파일을 읽을 수 없어요: %w", err)
+		}
+		data = fileData
+		source = path
+	}
+
+	var profile selectorProfile
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return fmt.Errorf("셀렉터 프로필 형식이 올바르지 않아요 (%s): %w", source, err)
+	}
+	if err := validateSelectorProfile(profile); err != nil {
+		return fmt.Errorf("셀렉터 프로필 검증 실패 (%s): %w", source, err)
+	}
+
+	selectorRegistry = profile
+	fmt.Fprintf(out, "✅ 셀렉터 프로필 v%d을 불러왔어요 (%s)\n", profile.Version, source)
+	return nil
+}
+
+func validateSelectorProfile(profile selectorProfile) error {
+	if profile.Version != selectorProfileVersion {
+		return fmt.Errorf("지원하지 않는 버전이에요 (파일: %d, 지원: %d)", profile.Version, selectorProfileVersion)
+	}
+
+	var missing []string
+	for _, key := range requiredSelectorKeys {
+		if strings.TrimSpace(profile.Selectors[key]) == "" {
+			missing = append(missing, "selectors."+key)
+		}
+	}
+	for _, key := range requiredColumnKeys {
+		if index, ok := profile.Columns[key]; !ok || index < 0 {
+			missing = append(missing, "columns."+key)
+		}
+		if strings.TrimSpace(profile.Headers[key]) == "" {
+			missing = append(missing, "columnHeaders."+key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("필수 항목이 없거나 잘못되었어요: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// 등록된 셀렉터를 키로 조회
+func sel(key string) string {
+	return selectorRegistry.Selectors[key]
+}
+
+// 조회 결과 표에서 해당 정보가 있는 td 인덱스
+func col(key string) int {
+	return selectorRegistry.Columns[key]
+}
+
+// 조회 결과 한 줄에 있어야 하는 최소 td 개수
+func minColumnCount() int {
+	count := 0
+	for _, index := range selectorRegistry.Columns {
+		if index+1 > count {
+			count = index + 1
+		}
+	}
+	return count
+}
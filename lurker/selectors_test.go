@@ -0,0 +1,44 @@
+package lurker
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestValidateSelectorProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(profile *selectorProfile)
+		wantErr string
+	}{
+		{"내장 기본값", func(profile *selectorProfile) {}, ""},
+		{"예약 확인 셀렉터가 빠짐", func(profile *selectorProfile) {
+			delete(profile.Selectors, "reservationConfirmation")
+		}, "selectors.reservationConfirmation"},
+		{"이전 버전", func(profile *selectorProfile) { profile.Version = 2 }, "지원하지 않는 버전"},
+		{"열 제목이 빠짐", func(profile *selectorProfile) {
+			delete(profile.Headers, "standard")
+		}, "columnHeaders.standard"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var profile selectorProfile
+			if err := json.Unmarshal(defaultSelectorProfile, &profile); err != nil {
+				t.Fatal(err)
+			}
+			tt.edit(&profile)
+
+			err := validateSelectorProfile(profile)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("err = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
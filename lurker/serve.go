@@ -0,0 +1,133 @@
+package lurker
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🛰️ 무인 실행과 HTTP 제어 API (serve)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🛰️ 입력을 묻지 않고 작업을 실행하면서 제어 API를 여는 함수
+// 비밀번호를 물어볼 수 없으므로 예약 모드는 보관함 자격 증명(--credential 또는 승객 프로필)이 필요해요
+func runServeCommand(listenAddr string) int {
+	token := os.Getenv("SERVE_TOKEN")
+	if !isLoopbackAddress(listenAddr) && token == "" {
+		fmt.Printf("❌ %s는 다른 컴퓨터에서도 접속할 수 있어요. SERVE_TOKEN을 설정하거나 127.0.0.1로 열어주세요\n", listenAddr)
+		return exitUsage
+	}
+
+	job := Job{Mode: firstNonEmpty(runOptions.mode, "reserve")}
+	profiles, err := loadProfiles()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitFailure
+	}
+	if _, err := applyJobPresets(&job, profiles); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return exitUsage
+	}
+
+	var missing []string
+	if job.From == "" || job.To == "" {
+		missing = append(missing, "출발/도착역 (--from/--to 또는 --route)")
+	}
+	if job.Date == "" {
+		missing = append(missing, "날짜 (--date)")
+	}
+	if job.Mode == "reserve" {
+		if job.DeptTime == "" || job.ArrivalTime == "" {
+			missing = append(missing, "출발/도착 시간 (--dept-time/--arrival-time 또는 --route)")
+		}
+		if job.Credential == "" {
+			missing = append(missing, "보관함 자격 증명 (--credential 또는 credential이 있는 --passenger)")
+		}
+	}
+	if len(missing) > 0 {
+		fmt.Println("❌ serve에 필요한 정보가 없어요:")
+		for _, item := range missing {
+			fmt.Printf("   - %s\n", item)
+		}
+		return exitUsage
+	}
+	if job.Mode == "reserve" {
+		if err := applyVaultCredential(&job, job.Credential, promptVaultPassphrase, os.Stdout); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return exitFailure
+		}
+	}
+
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		fmt.Printf("❌ 제어 API를 열 수 없어요 (%s): %v\n", listenAddr, err)
+		return exitFailure
+	}
+	return runCLIJobWith(job, func(s *session) {
+		startControlServer(listener, token, s)
+		fmt.Printf("🛰️ 제어 API를 열었어요: http://%s/status (POST /pause, /resume, /stop)\n", listenAddr)
+	})
+}
+
+// 🔌 상태 조회와 일시정지/재개/중지를 받는 HTTP 서버를 띄우는 함수 (텔레그램 명령과 같은 동작)
+func startControlServer(listener net.Listener, token string, s *session) {
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		if token == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+token)) == 1 {
+			return true
+		}
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return false
+	}
+	reply := func(w http.ResponseWriter, text string) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintln(w, text)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			reply(w, s.runStatusText())
+		}
+	})
+	mux.HandleFunc("POST /pause", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			s.setPaused(true)
+			s.println("   🛰️ 제어 API 명령 수신: pause")
+			reply(w, "⏸️ 다음 시도부터 일시정지할게요")
+		}
+	})
+	mux.HandleFunc("POST /resume", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			s.setPaused(false)
+			s.println("   🛰️ 제어 API 명령 수신: resume")
+			reply(w, "▶ 다시 시작할게요")
+		}
+	})
+	mux.HandleFunc("POST /stop", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			s.requestStop()
+			s.println("   🛰️ 제어 API 명령 수신: stop")
+			reply(w, "⛔ 진행 중인 시도가 끝나면 중지할게요")
+		}
+	})
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go server.Serve(listener)
+}
+
+// 이 컴퓨터에서만 접속할 수 있는 주소인지 (localhost, 127.0.0.1, ::1)
+func isLoopbackAddress(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,172 @@
+package lurker
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🧰 첫 실행 설정 (setup)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🧰 setup에서 받은 답 (비어 있는 항목은 설정 파일에 쓰지 않고 내장 기본값을 씀)
+type setupAnswers struct {
+	publicMode     bool
+	accessKey      string // bcrypt 해시
+	smtpHost       string
+	smtpPort       string
+	sender         string
+	senderPassword string
+	slackURL       string
+	discordURL     string
+	telegramToken  string
+	telegramChatID string
+}
+
+// 🧰 질문에 답하면 사용자 설정 폴더에 config.yaml을 만드는 함수 (종료 코드 반환)
+// 실행할 때와 같은 로더로 다시 읽어 점검하므로 'config validate'도 이 파일을 그대로 확인해요
+func runSetup() int {
+	path := firstNonEmpty(os.Getenv("CONFIG_FILE"), userYAMLConfigPath())
+	if path == "" {
+		fmt.Println("❌ 사용자 설정 폴더를 찾을 수 없어요. CONFIG_FILE로 설정 파일 경로를 지정해주세요")
+		return exitFailure
+	}
+
+	printHeader("🧰 SRT Lurker 설정")
+	fmt.Printf("   📁 설정 파일: %s\n", path)
+	fmt.Println("   ℹ️ 비밀번호는 이 파일에만 저장되고, 파일은 현재 사용자만 읽을 수 있어요")
+	if _, err := os.Stat(path); err == nil {
+		if !getYesNoInput("이미 설정 파일이 있어요. 새로 만들까요?", false) {
+			return exitOK
+		}
+	}
+
+	var answers setupAnswers
+
+	printSubHeader("🔐 접근 제어")
+	answers.publicMode = getYesNoInput("누구나 사용할 수 있게 할까요? (N이면 접근 암호 필요)", true)
+	if !answers.publicMode {
+		hash, err := promptAccessKeyHash()
+		if err != nil {
+			fmt.Printf("   ❌ %v\n", err)
+			return exitFailure
+		}
+		answers.accessKey = hash
+	}
+
+	printSubHeader("📧 이메일 알림 (SMTP)")
+	if getYesNoInput("이메일 알림을 보낼 SMTP 계정을 설정할까요?", false) {
+		answers.smtpHost = getUserInput("SMTP 서버", emailConfig.smtpHost, "smtp.gmail.com")
+		answers.smtpPort = getInputWithValidation("SMTP 포트", emailConfig.smtpPort, func(s string) bool {
+			if n, err := strconv.Atoi(s); err != nil || n <= 0 || n > 65535 {
+				fmt.Println("   ❌ 1~65535 사이의 숫자를 입력해주세요")
+				return false
+			}
+			return true
+		}, "587", "465")
+		answers.sender = getInputWithValidation("보내는 이메일 주소", "",
+			func(s string) bool { return validateRequired(s, "이메일 주소") && validateEmail(s) }, "me@gmail.com")
+		answers.senderPassword = getPasswordInput("SMTP 비밀번호 (Gmail은 앱 비밀번호)")
+	}
+
+	printSubHeader("💬 채팅 알림 (선택)")
+	fmt.Println("   ℹ️ 사용하지 않는 항목은 Enter로 건너뛰세요")
+	answers.slackURL = getUserInput("Slack 웹훅 URL", "")
+	answers.discordURL = getUserInput("Discord 웹훅 URL", "")
+	if answers.telegramToken = getUserInput("텔레그램 봇 토큰", ""); answers.telegramToken != "" {
+		answers.telegramChatID = getUserInput("텔레그램 채팅 ID", "")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		fmt.Printf("   ❌ 설정 폴더를 만들 수 없어요: %v\n", err)
+		return exitFailure
+	}
+	if err := os.WriteFile(path, []byte(answers.configYAML(time.Now())), 0o600); err != nil {
+		fmt.Printf("   ❌ 설정 파일 저장 실패: %v\n", err)
+		return exitFailure
+	}
+	fmt.Println()
+	fmt.Printf("   ✅ 설정 파일을 저장했어요: %s\n", path)
+
+	// 예전 setup이 만든 config.env는 환경변수처럼 config.yaml보다 우선해서 새 설정을 가릴 수 있음
+	if legacy := userConfigPath(); legacy != "" {
+		if _, err := os.Stat(legacy); err == nil {
+			fmt.Printf("   ⚠️ 예전 setup이 만든 %s도 있어요. 이 파일의 값은 config.yaml보다 우선해요\n", legacy)
+			if getYesNoInput("config.env.bak으로 이름을 바꿔 config.yaml만 쓸까요?", true) {
+				if err := os.Rename(legacy, legacy+".bak"); err != nil {
+					fmt.Printf("   ❌ 이름을 바꾸지 못했어요: %v\n", err)
+				}
+			}
+		}
+	}
+
+	// 실행할 때와 같은 로더로 다시 읽어 점검
+	runOptions.configPath = path
+	loadConfig(io.Discard)
+	if problems := validateConfig(); len(problems) > 0 {
+		printConfigProblems(problems)
+		fmt.Println("💡 설정을 고친 뒤 'config validate'로 다시 확인해주세요")
+		return exitFailure
+	}
+	fmt.Println("   ✅ 설정에 문제가 없어요. 이제 .env 없이 바로 실행할 수 있어요")
+	return exitOK
+}
+
+// 📄 답을 config.yaml 형식으로 만드는 함수 (config.default.yaml과 같은 키 이름)
+func (answers setupAnswers) configYAML(createdAt time.Time) string {
+	lines := []string{
+		"# SRT Lurker 설정 파일 (setup 명령으로 생성, " + createdAt.Format("2006-01-02") + ")",
+		"# 적지 않은 설정은 내장 기본값을 써요. 전체 설정은 'config defaults'로 볼 수 있어요",
+		"",
+		"access:",
+		"  publicMode: " + strconv.FormatBool(answers.publicMode),
+	}
+	if answers.accessKey != "" {
+		lines = append(lines, "  accessKey: "+yamlString(answers.accessKey)+"  # 'access add'로 이름 있는 키를 더 등록할 수 있어요")
+	}
+
+	var notification []string
+	if answers.smtpHost != "" {
+		notification = append(notification,
+			"  email:",
+			"    smtpHost: "+yamlString(answers.smtpHost),
+			"    smtpPort: "+yamlString(answers.smtpPort),
+			"    sender: "+yamlString(answers.sender),
+			"    password: "+yamlString(answers.senderPassword),
+		)
+	}
+	if answers.slackURL != "" {
+		notification = append(notification, "  slack:", "    webhookURL: "+yamlString(answers.slackURL))
+	}
+	if answers.discordURL != "" {
+		notification = append(notification, "  discord:", "    webhookURL: "+yamlString(answers.discordURL))
+	}
+	if answers.telegramToken != "" {
+		notification = append(notification,
+			"  telegram:",
+			"    botToken: "+yamlString(answers.telegramToken),
+			"    chatID: "+yamlString(answers.telegramChatID),
+		)
+	}
+	if len(notification) > 0 {
+		lines = append(lines, "", "notification:")
+		lines = append(lines, notification...)
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// YAML 문자열 값으로 안전하게 쓰는 함수 (#, :, 따옴표가 있으면 YAML 규칙대로 따옴표로 감쌈)
+func yamlString(value string) string {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return strconv.Quote(value)
+	}
+	return strings.TrimSuffix(string(data), "\n")
+}
@@ -0,0 +1,20 @@
+package lurker
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🚄 SRT 역 목록
+// ═══════════════════════════════════════════════════════════════════════════════
+
+var srtStations = []string{
+	"수서", "동탄", "평택지제", "천안아산", "오송", "대전", "김천구미", "동대구",
+	"경주", "울산", "부산", "광명", "서대전", "익산", "정읍", "광주송정", "전주",
+	"남원", "곡성", "구례구", "순천", "여천", "여수EXPO", "신경주", "포항",
+}
+
+func isSRTStation(name string) bool {
+	for _, station := range srtStations {
+		if station == name {
+			return true
+		}
+	}
+	return false
+}
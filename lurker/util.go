@@ -0,0 +1,34 @@
+package lurker
+
+import (
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🔧 유틸리티 함수들
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🌟 로딩 애니메이션을 표시하는 함수
+func (s *session) showLoadingAnimation(message string, duration int) {
+	done := make(chan bool)
+
+	go func() {
+		// 더 예쁜 유니코드 스피너 (브라이 패턴)
+		spinner := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+		i := 0
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				s.printf("\r   %s %s", spinner[i%len(spinner)], message)
+				i++
+				time.Sleep(100 * time.Millisecond) // 더 빠른 속도
+			}
+		}
+	}()
+
+	s.sleep(time.Duration(duration) * time.Second)
+	done <- true
+	s.printf("\r   ✓ %s (완료)\n", message)
+}
@@ -0,0 +1,373 @@
+package lurker
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 🔐 암호화된 자격 증명 보관함 (vault)
+// ═══════════════════════════════════════════════════════════════════════════════
+
+const (
+	vaultFormatVersion = 1
+	vaultScryptN       = 1 << 15 // scrypt 비용 (키 유도에 약 0.1초)
+	vaultScryptR       = 8
+	vaultScryptP       = 1
+	vaultKeyLength     = 32 // AES-256
+	vaultAssociated    = "srt-lurker-vault-v1"
+)
+
+// 🔐 보관함에 저장하는 자격 증명 하나
+type vaultCredential struct {
+	Kind      string    `json:"kind"`                // "login" 또는 "unregistered"
+	LoginType string    `json:"loginType,omitempty"` // 로그인 고객: "member", "email", "phone"
+	LoginID   string    `json:"loginId,omitempty"`   // 로그인 고객: 회원번호/이메일/전화번호
+	Name      string    `json:"name,omitempty"`      // 미등록 고객: 예약자 이름
+	Phone     string    `json:"phone,omitempty"`     // 미등록 고객: 전화번호
+	Password  string    `json:"password"`            // 로그인 비밀번호 또는 미등록 고객 5자리 비밀번호
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// 💾 디스크에 저장되는 보관함 파일 (이름을 포함한 모든 자격 증명은 암호문 안에 있음)
+type vaultFile struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	N          int    `json:"n"`
+	R          int    `json:"r"`
+	P          int    `json:"p"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// 보관함 파일 경로 (VAULT_FILE 또는 상태 폴더/vault.json)
+func vaultPath() string {
+	if path := os.Getenv("VAULT_FILE"); path != "" {
+		return path
+	}
+	return filepath.Join(stateDir(), "vault.json")
+}
+
+func vaultExists() bool {
+	_, err := os.Stat(vaultPath())
+	return err == nil
+}
+
+// 🔑 명령행 도구가 작업을 실행할 때 쓰는 보관함 암호 (환경변수가 없으면 터미널에서 물어봄)
+var promptVaultPassphrase SecretSource = func() (string, error) {
+	return vaultPassphrase(false)
+}
+
+// 🔑 작업 실행에 쓸 보관함 암호를 정하는 함수 (source가 없으면 VAULT_PASSPHRASE 환경변수만 쓰고 묻지 않음)
+func resolveVaultPassphrase(source SecretSource) (string, error) {
+	if source != nil {
+		return source()
+	}
+	if passphrase := os.Getenv("VAULT_PASSPHRASE"); passphrase != "" {
+		return passphrase, nil
+	}
+	return "", fmt.Errorf("보관함 암호가 없어요. Options.VaultPassphrase나 VAULT_PASSPHRASE 환경변수로 알려주세요")
+}
+
+// 🔑 보관함 암호를 읽는 함수 (무인 실행은 VAULT_PASSPHRASE 환경변수 사용)
+func vaultPassphrase(creating bool) (string, error) {
+	if passphrase := os.Getenv("VAULT_PASSPHRASE"); passphrase != "" {
+		return passphrase, nil
+	}
+
+	passphrase := getPasswordInput("보관함 암호를 입력하세요")
+	if passphrase == "" {
+		return "", fmt.Errorf("보관함 암호가 비어 있어요")
+	}
+	if creating {
+		if len(passphrase) < 8 {
+			return "", fmt.Errorf("보관함 암호는 8자 이상이어야 해요")
+		}
+		if getPasswordInput("보관함 암호를 한 번 더 입력하세요") != passphrase {
+			return "", fmt.Errorf("두 암호가 서로 달라요")
+		}
+	}
+	return passphrase, nil
+}
+
+func vaultCipher(passphrase string, salt []byte, n, r, p int) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, n, r, p, vaultKeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("암호화 키 생성 실패: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// 🔓 보관함을 복호화해 읽는 함수 (파일이 없으면 빈 보관함)
+func loadVault(passphrase string) (map[string]vaultCredential, error) {
+	credentials := map[string]vaultCredential{}
+
+	data, err := os.ReadFile(vaultPath())
+	if errors.Is(err, fs.ErrNotExist) {
+		return credentials, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("보관함 파일을 읽을 수 없어요: %w", err)
+	}
+
+	var file vaultFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("보관함 파일 형식이 올바르지 않아요: %w", err)
+	}
+	if file.Version != vaultFormatVersion || file.KDF != "scrypt" {
+		return nil, fmt.Errorf("지원하지 않는 보관함 형식이에요 (버전 %d, %s)", file.Version, file.KDF)
+	}
+
+	aead, err := vaultCipher(passphrase, file.Salt, file.N, file.R, file.P)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, file.Nonce, file.Ciphertext, []byte(vaultAssociated))
+	if err != nil {
+		return nil, fmt.Errorf("보관함 암호가 틀렸거나 파일이 손상되었어요")
+	}
+	if err := json.Unmarshal(plaintext, &credentials); err != nil {
+		return nil, fmt.Errorf("보관함 내용을 해석할 수 없어요: %w", err)
+	}
+	return credentials, nil
+}
+
+// 🔒 보관함을 암호화해 저장하는 함수 (저장할 때마다 salt와 nonce를 새로 만듦)
+func saveVault(passphrase string, credentials map[string]vaultCredential) error {
+	plaintext, err := json.Marshal(credentials)
+	if err != nil {
+		return err
+	}
+
+	file := vaultFile{
+		Version: vaultFormatVersion,
+		KDF:     "scrypt",
+		N:       vaultScryptN,
+		R:       vaultScryptR,
+		P:       vaultScryptP,
+		Salt:    make([]byte, 16),
+	}
+	if _, err := rand.Read(file.Salt); err != nil {
+		return err
+	}
+	aead, err := vaultCipher(passphrase, file.Salt, file.N, file.R, file.P)
+	if err != nil {
+		return err
+	}
+	file.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return err
+	}
+	file.Ciphertext = aead.Seal(nil, file.Nonce, plaintext, []byte(vaultAssociated))
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(vaultPath()); dir != "" {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return err
+		}
+	}
+	tmpPath := vaultPath() + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("보관함 저장 실패: %w", err)
+	}
+	if err := os.Rename(tmpPath, vaultPath()); err != nil {
+		return fmt.Errorf("보관함 저장 실패: %w", err)
+	}
+	return nil
+}
+
+// 🔐 보관함의 자격 증명을 예약 정보에 채우는 함수
+func applyVaultCredential(job *Job, name string, passphrase SecretSource, out io.Writer) error {
+	if !vaultExists() {
+		return fmt.Errorf("보관함이 없어요 (%s). 먼저 'vault add %s'로 저장해주세요", vaultPath(), name)
+	}
+	secret, err := resolveVaultPassphrase(passphrase)
+	if err != nil {
+		return err
+	}
+	credentials, err := loadVault(secret)
+	if err != nil {
+		return err
+	}
+	return applyStoredCredential(job, name, credentials, out)
+}
+
+// 🔐 이미 연 보관함에서 이름으로 자격 증명을 찾아 예약 정보에 채우는 함수 (여러 작업이 암호를 한 번만 묻도록)
+func applyStoredCredential(job *Job, name string, credentials map[string]vaultCredential, out io.Writer) error {
+	credential, ok := credentials[name]
+	if !ok {
+		return fmt.Errorf("보관함에 '%s' 자격 증명이 없어요", name)
+	}
+
+	job.CustomerType = credential.Kind
+	switch credential.Kind {
+	case "login":
+		job.LoginType = credential.LoginType
+		job.LoginID = credential.LoginID
+		job.LoginPassword = credential.Password
+		fmt.Fprintf(out, "   ✅ '%s' 자격 증명으로 로그인 고객 예매를 진행할게요 (%s)\n", name, redactLoginID(credential.LoginType, credential.LoginID))
+	case "unregistered":
+		job.Name = credential.Name
+		job.Phone = credential.Phone
+		job.Password = credential.Password
+		fmt.Fprintf(out, "   ✅ '%s' 자격 증명으로 미등록 고객 예매를 진행할게요 (%s)\n", name, credential.Name)
+	default:
+		return fmt.Errorf("'%s' 자격 증명의 유형을 알 수 없어요: %s", name, credential.Kind)
+	}
+	return nil
+}
+
+// 🗝️ vault add/list/remove 명령을 처리하는 함수 (종료 코드 반환)
+func runVaultCommand(args []string) int {
+	if len(args) == 0 {
+		fmt.Println("사용법: vault add <이름> | vault list | vault remove <이름>")
+		return exitUsage
+	}
+
+	command := args[0]
+	if (command == "add" || command == "remove") && len(args) < 2 {
+		fmt.Printf("사용법: vault %s <이름>\n", command)
+		return exitUsage
+	}
+
+	printHeader("🔐 자격 증명 보관함")
+	fmt.Printf("   📁 %s\n", vaultPath())
+
+	creating := command == "add" && !vaultExists()
+	if creating {
+		fmt.Println("   ℹ️ 새 보관함을 만들어요. 이 암호를 잊으면 저장한 정보를 복구할 수 없어요")
+	} else if command != "list" && command != "remove" && command != "add" {
+		fmt.Printf("   ❌ 알 수 없는 명령이에요: %s\n", command)
+		return exitUsage
+	}
+	passphrase, err := vaultPassphrase(creating)
+	if err != nil {
+		fmt.Printf("   ❌ %v\n", err)
+		return exitFailure
+	}
+	credentials, err := loadVault(passphrase)
+	if err != nil {
+		fmt.Printf("   ❌ %v\n", err)
+		return exitFailure
+	}
+
+	switch command {
+	case "list":
+		if len(credentials) == 0 {
+			fmt.Println("   ℹ️ 저장된 자격 증명이 없어요")
+			return exitOK
+		}
+		names := make([]string, 0, len(credentials))
+		for name := range credentials {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			credential := credentials[name]
+			if credential.Kind == "login" {
+				fmt.Printf("   • %s: 로그인 고객 (%s, %s)\n", name, credential.LoginType, redactLoginID(credential.LoginType, credential.LoginID))
+			} else {
+				fmt.Printf("   • %s: 미등록 고객 (%s)\n", name, credential.Name)
+			}
+		}
+		return exitOK
+
+	case "remove":
+		name := args[1]
+		if _, ok := credentials[name]; !ok {
+			fmt.Printf("   ❌ '%s' 자격 증명이 없어요\n", name)
+			return exitFailure
+		}
+		delete(credentials, name)
+
+	case "add":
+		name := args[1]
+		if _, ok := credentials[name]; ok && !getYesNoInput(fmt.Sprintf("'%s' 자격 증명이 이미 있어요. 덮어쓸까요?", name), false) {
+			return exitFailure
+		}
+		credentials[name] = promptVaultCredential()
+	}
+
+	if err := saveVault(passphrase, credentials); err != nil {
+		fmt.Printf("   ❌ %v\n", err)
+		return exitFailure
+	}
+	fmt.Println("   ✅ 보관함을 저장했어요")
+	return exitOK
+}
+
+// 자격 증명 하나를 입력받는 함수 (비밀번호는 화면에 표시하지 않음)
+func promptVaultCredential() vaultCredential {
+	credential := vaultCredential{CreatedAt: time.Now()}
+
+	fmt.Println("   1. 미등록 고객 (이름, 전화번호, 5자리 비밀번호)")
+	fmt.Println("   2. 로그인 고객 (회원번호/이메일/전화번호와 비밀번호)")
+	for {
+		choice := getUserInput("자격 증명 유형을 선택하세요 (1 또는 2)", "2")
+		if choice == "1" || choice == "2" {
+			credential.Kind = map[string]string{"1": "unregistered", "2": "login"}[choice]
+			break
+		}
+		fmt.Println("   ❌ 1 또는 2를 입력해주세요")
+	}
+
+	if credential.Kind == "unregistered" {
+		credential.Name = getInputWithValidation("예약자 이름을 입력하세요", "",
+			func(s string) bool { return validateRequired(s, "예약자 이름") }, "홍길동")
+		credential.Phone = getInputWithValidation("전화번호를 입력하세요 (숫자만)", "",
+			func(s string) bool { return validateRequired(s, "전화번호") && validatePhone(s) }, "01012345678")
+		for {
+			credential.Password = getPasswordInput("비밀번호를 입력하세요 (5자리 숫자)")
+			if validatePassword(credential.Password) {
+				break
+			}
+		}
+		return credential
+	}
+
+	for {
+		choice := getUserInput("로그인 타입을 선택하세요 (1: 회원번호, 2: 이메일, 3: 전화번호)", "1")
+		loginType, ok := map[string]string{"1": "member", "2": "email", "3": "phone"}[choice]
+		if ok {
+			credential.LoginType = loginType
+			break
+		}
+		fmt.Println("   ❌ 1, 2, 3 중 하나를 입력해주세요")
+	}
+	credential.LoginID = getInputWithValidation("로그인 ID를 입력하세요", "", func(s string) bool {
+		switch credential.LoginType {
+		case "email":
+			return validateRequired(s, "이메일") && validateEmail(s)
+		case "phone":
+			return validateRequired(s, "전화번호") && validatePhone(s)
+		}
+		return validateRequired(s, "회원번호")
+	})
+	for {
+		credential.Password = getPasswordInput("로그인 비밀번호를 입력하세요")
+		if validateRequired(credential.Password, "로그인 비밀번호") {
+			break
+		}
+	}
+	return credential
+}
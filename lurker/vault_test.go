@@ -0,0 +1,166 @@
+package lurker
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVaultRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vault.json")
+	t.Setenv("VAULT_FILE", path)
+
+	credentials := map[string]vaultCredential{
+		"alice": {Kind: "unregistered", Name: "홍길동", Phone: "01012345678", Password: "12345",
+			CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		"bob-login": {Kind: "login", LoginType: "member", LoginID: "1234567890", Password: "p@ss word",
+			CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+	}
+	if err := saveVault("correct horse", credentials); err != nil {
+		t.Fatalf("saveVault: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, plain := range []string{"alice", "홍길동", "01012345678", "1234567890"} {
+		if bytes.Contains(data, []byte(plain)) {
+			t.Errorf("보관함 파일에 %q가 암호화되지 않은 채 들어 있어요", plain)
+		}
+	}
+	if info, err := os.Stat(path); err == nil && info.Mode().Perm()&0o077 != 0 {
+		t.Errorf("보관함 파일 권한 = %v, 다른 사용자가 읽을 수 있어요", info.Mode().Perm())
+	}
+
+	tests := []struct {
+		name       string
+		passphrase string
+		wantErr    string
+	}{
+		{"맞는 암호", "correct horse", ""},
+		{"틀린 암호", "wrong horse", "암호가 틀렸거나"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loaded, err := loadVault(tt.passphrase)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(loaded, credentials) {
+				t.Errorf("읽은 보관함\n got %+v\nwant %+v", loaded, credentials)
+			}
+		})
+	}
+}
+
+func TestLoadVaultMissingFile(t *testing.T) {
+	t.Setenv("VAULT_FILE", filepath.Join(t.TempDir(), "없음.json"))
+	credentials, err := loadVault("아무 암호")
+	if err != nil || len(credentials) != 0 {
+		t.Errorf("파일이 없으면 빈 보관함이어야 해요 (got %v, %v)", credentials, err)
+	}
+}
+
+func TestApplyStoredCredential(t *testing.T) {
+	credentials := map[string]vaultCredential{
+		"alice": {Kind: "unregistered", Name: "홍길동", Phone: "01012345678", Password: "12345"},
+		"bob":   {Kind: "login", LoginType: "email", LoginID: "bob@example.com", Password: "secret"},
+		"odd":   {Kind: "guest"},
+	}
+	tests := []struct {
+		name    string
+		want    Job
+		wantErr bool
+	}{
+		{"alice", Job{CustomerType: "unregistered", Name: "홍길동", Phone: "01012345678", Password: "12345"}, false},
+		{"bob", Job{CustomerType: "login", LoginType: "email", LoginID: "bob@example.com", LoginPassword: "secret"}, false},
+		{"odd", Job{}, true},
+		{"없는 이름", Job{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var job Job
+			err := applyStoredCredential(&job, tt.name, credentials, io.Discard)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(job, tt.want) {
+				t.Errorf("got %+v, want %+v", job, tt.want)
+			}
+		})
+	}
+}
+
+func TestLibraryRunNeverPromptsForPassphrase(t *testing.T) {
+	t.Setenv("VAULT_FILE", filepath.Join(t.TempDir(), "vault.json"))
+	t.Setenv("VAULT_PASSPHRASE", "")
+	credentials := map[string]vaultCredential{
+		"alice": {Kind: "unregistered", Name: "홍길동", Phone: "01012345678", Password: "12345"},
+	}
+	if err := saveVault("correct horse", credentials); err != nil {
+		t.Fatal(err)
+	}
+
+	// 암호를 물으면 읽기가 끝나지 않도록 아무것도 쓰지 않는 표준 입력
+	stdin, input, _ := os.Pipe()
+	defer input.Close()
+	savedStdin := os.Stdin
+	os.Stdin = stdin
+	defer func() { os.Stdin = savedStdin }()
+
+	job := Job{Mode: "reserve", From: "수서", To: "부산", Date: "20260301", DeptTime: "08:00", Credential: "alice"}
+	wrong := func() (string, error) { return "wrong horse", nil }
+	tests := []struct {
+		name    string
+		run     func() error
+		wantErr string
+	}{
+		{"Run 암호 없음", func() error {
+			_, err := run(context.Background(), job, Options{Output: io.Discard}, nil)
+			return err
+		}, "보관함 암호가 없어요"},
+		{"Run 옵션 암호", func() error {
+			_, err := run(context.Background(), job, Options{Output: io.Discard, VaultPassphrase: wrong}, nil)
+			return err
+		}, "암호가 틀렸거나"},
+		{"RunJobs 암호 없음", func() error {
+			batchJob := job
+			batchJob.ID = "alice"
+			_, err := runBatch(context.Background(), []Job{batchJob}, BatchOptions{Output: io.Discard, LogDir: t.TempDir()})
+			return err
+		}, "보관함 암호가 없어요"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			done := make(chan error, 1)
+			go func() { done <- tt.run() }()
+			select {
+			case err := <-done:
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("err = %v, want %q", err, tt.wantErr)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("보관함 암호를 터미널에서 묻고 있어요")
+			}
+		})
+	}
+
+	filled := job
+	err := applyVaultCredential(&filled, "alice", func() (string, error) { return "correct horse", nil }, io.Discard)
+	if err != nil || filled.Name != "홍길동" {
+		t.Errorf("옵션 암호로 자격 증명을 채우지 못했어요: %v (%+v)", err, filled)
+	}
+}
@@ -0,0 +1,98 @@
+package lurker
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 👀 빈자리 감시 모드 함수들
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 🔗 감시 중인 조회 조건으로 바로 이동할 수 있는 링크
+func (s *session) watchDeepLink() string {
+	query := url.Values{}
+	query.Set("dptRsStnCdNm", s.job.From)
+	query.Set("arvRsStnCdNm", s.job.To)
+	query.Set("dptDt", s.job.Date)
+	query.Set("dptTm", strings.ReplaceAll(s.job.DeptTime, ":", "")+"00")
+	return initialURL + "&" + query.Encode()
+}
+
+func (s *session) pollAvailability(page playwright.Page, poll int) ([]trainAvailability, error) {
+	if poll > 1 {
+		if _, err := page.Reload(); err != nil {
+			return nil, fmt.Errorf("페이지 새로고침 실패: %w", err)
+		}
+		s.showLoadingAnimation("페이지를 새로고침하고 있어요", 3)
+	}
+
+	for _, step := range []func(playwright.Page) error{
+		s.step1SetStations,
+		s.step2SetDate,
+		s.step3SearchTrains,
+	} {
+		if err := step(page); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.readTrainAvailability(page)
+}
+
+// 👀 빈자리가 생기면 알림만 보내는 감시 루프 (예약하기는 누르지 않음)
+// 원격 중지 명령을 받으면 돌아와요
+func (s *session) runWatchMode(page playwright.Page) {
+	// 열차번호+좌석등급 별 직전 상태
+	lastStatus := map[string]string{}
+
+	for poll := 1; ; poll++ {
+		s.waitWhilePaused()
+		if s.stopRequested() {
+			return
+		}
+
+		s.printf("\n👀 감시 %d회차 조회...\n", poll)
+		s.println(strings.Repeat("=", 50))
+		s.updateRunStatus(poll, "")
+
+		trains, err := s.pollShared(page, poll)
+		if err != nil {
+			s.printf("✗ 조회 실패: %v\n", err)
+			s.updateRunStatus(poll, err.Error())
+		}
+		s.recordAttemptOutcome(err, "조회 성공")
+
+		for _, train := range trains {
+			s.recordTrainSeen(train)
+			s.printf("   🚆 %s열차 %s → %s | 특실: %s | 일반실: %s\n",
+				train.trainNumber, train.deptText, train.arrivalText, train.premium, train.standard)
+
+			for seatClass, status := range map[string]string{"특실": train.premium, "일반실": train.standard} {
+				key := train.trainNumber + "/" + seatClass
+				previous, seen := lastStatus[key]
+				lastStatus[key] = status
+
+				if seatOpened(previous, seen, status) {
+					s.printf("   🔔 %s열차 %s 빈자리 발생!\n", train.trainNumber, seatClass)
+					event := s.newNotificationEvent(eventSeatFound, poll, "")
+					event.train = train
+					event.seatClass = seatClass
+					s.dispatchNotification(event)
+				}
+			}
+		}
+		s.maybeSendDigest()
+
+		s.showLoadingAnimation("다음 조회를 기다리는 중이에요", retryConfig.watchInterval)
+	}
+}
+
+// 🔔 빈자리 알림을 보낼지 판단하는 함수
+// 처음 조회했을 때 이미 예약가능이거나, 직전 조회에서 예약가능이 아니었다가 예약가능이 되면 알려요
+func seatOpened(previous string, seen bool, status string) bool {
+	return status == "예약가능" && (!seen || previous != "예약가능")
+}
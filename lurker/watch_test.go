@@ -0,0 +1,27 @@
+package lurker
+
+import "testing"
+
+func TestSeatOpened(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous string
+		seen     bool
+		status   string
+		want     bool
+	}{
+		{"처음 조회에서 이미 예약가능", "", false, "예약가능", true},
+		{"처음 조회에서 매진", "", false, "매진", false},
+		{"매진에서 예약가능", "매진", true, "예약가능", true},
+		{"좌석 없음에서 예약가능", "-", true, "예약가능", true},
+		{"계속 예약가능", "예약가능", true, "예약가능", false},
+		{"예약가능에서 매진", "예약가능", true, "매진", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := seatOpened(tt.previous, tt.seen, tt.status); got != tt.want {
+				t.Errorf("seatOpened(%q, %v, %q) = %v, want %v", tt.previous, tt.seen, tt.status, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package lurker
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════════
+// 📝 사용자 입력 수집 메인 함수
+// ═══════════════════════════════════════════════════════════════════════════════
+
+// 📝 빠진 여정/예약자/알림 정보를 물어 job을 채우는 함수 (프로필과 명령행으로 정한 항목은 건너뜀)
+func collectUserInput(job *Job) {
+	printHeader("SRT 고속열차 예약 시스템")
+	fmt.Println("   🎯 예약에 필요한 정보를 입력해주세요")
+	fmt.Println("   ℹ️  각 항목에 대한 예시를 참고하여 정확히 입력해주세요")
+
+	// 🚫 시스템 제한사항 공지
+	printSubHeader("⚠️  시스템 제한사항")
+	fmt.Println("   🚫 좌석 선택 기능: 현재 제공하지 않음 (자동 배정)")
+	fmt.Println("   🚫 인원 수 선택 기능: 현재 제공하지 않음 (1명 기준)")
+	fmt.Println("   ℹ️  위 기능들은 추후 업데이트 예정이에요")
+	fmt.Println()
+
+	// 🎛️ 실행 모드 선택 (run/watch 명령으로 정했으면 생략)
+	if runOptions.mode != "" {
+		job.Mode = runOptions.mode
+	} else {
+		printSubHeader("🎛️ 실행 모드 선택")
+		fmt.Println("   1. 자동 예약 (빈자리 발견 시 바로 예약)")
+		fmt.Println("   2. 빈자리 감시 (예약하지 않고 알림만 발송)")
+		fmt.Println()
+
+		for {
+			modeChoice := getUserInput("실행 모드를 선택하세요 (1 또는 2)", "1")
+			switch modeChoice {
+			case "1":
+				job.Mode = "reserve"
+				fmt.Println("   ✅ 자동 예약 모드로 진행할게요")
+				fmt.Println()
+			case "2":
+				job.Mode = "watch"
+				fmt.Println("   ✅ 빈자리 감시 모드로 진행할게요")
+				fmt.Println("   ℹ️  예약하기 버튼은 누르지 않고 빈자리가 보이면 알려드려요")
+				fmt.Println()
+			default:
+				fmt.Println("   ❌ 1 또는 2를 입력해주세요")
+				fmt.Println()
+				continue
+			}
+			break
+		}
+	}
+
+	// 👥 저장된 승객 프로필과 경로 프리셋 (--passenger, --route로 지정하거나 목록에서 선택)
+	profiles, err := loadProfiles()
+	if err != nil {
+		fmt.Printf("   ⚠️ %v\n", err)
+	}
+	if runOptions.passenger == "" && len(profiles.Passengers) > 0 {
+		runOptions.passenger = chooseProfile("👤 승객 프로필 선택", sortedKeys(profiles.Passengers),
+			func(name string) string { return describePassenger(profiles.Passengers[name]) })
+	}
+	if runOptions.route == "" && runOptions.from == "" && len(profiles.Routes) > 0 {
+		runOptions.route = chooseProfile("🛤️ 경로 프리셋 선택", sortedKeys(profiles.Routes),
+			func(name string) string { return describeRoute(profiles.Routes[name]) })
+	}
+	presets, err := applyJobPresets(job, profiles)
+	if err != nil {
+		fmt.Printf("   ❌ %v\n", err)
+		os.Exit(exitUsage)
+	}
+
+	// 👤 고객 유형 선택 (감시 모드는 예약하지 않으므로 생략, 보관함 자격 증명이나 승객 프로필이면 그 유형을 사용)
+	if job.Mode == "reserve" && job.Credential != "" {
+		printSubHeader("🔐 저장된 자격 증명")
+		if err := applyVaultCredential(job, job.Credential, promptVaultPassphrase, os.Stdout); err != nil {
+			fmt.Printf("   ❌ %v\n", err)
+			os.Exit(1)
+		}
+	} else if job.Mode == "reserve" && !presets.profileDetails {
+		printSubHeader("👤 고객 유형 선택")
+		fmt.Println("   1. 미등록 고객 예매 (회원가입 없이 예약)")
+		fmt.Println("   2. 로그인 고객 예매 (SRT 회원 로그인)")
+		fmt.Println()
+
+		for {
+			customerChoice := getUserInput("고객 유형을 선택하세요 (1 또는 2)", "1")
+			switch customerChoice {
+			case "1":
+				job.CustomerType = "unregistered"
+				fmt.Println("   ✅ 미등록 고객 예매로 진행할게요")
+				fmt.Println()
+				break
+			case "2":
+				job.CustomerType = "login"
+				fmt.Println("   ✅ 로그인 고객 예매로 진행할게요")
+				fmt.Println()
+				break
+			default:
+				fmt.Println("   ❌ 1 또는 2를 입력해주세요")
+				fmt.Println()
+				continue
+			}
+			break
+		}
+	}
+
+	// 역 정보 선택 (경로 프리셋이나 --from/--to로 정했으면 생략)
+	if job.From == "" || job.To == "" {
+		printSubHeader("🚉 역 정보")
+		fmt.Println("   출발역을 선택해주세요...")
+		time.Sleep(1 * time.Second)
+		job.From = selectStation("출발역을 선택하세요")
+
+		fmt.Printf("   ✅ 출발역: %s\n", job.From)
+		fmt.Println("   도착역을 선택해주세요...")
+		time.Sleep(1 * time.Second)
+		job.To = selectStation("도착역을 선택하세요")
+
+		fmt.Printf("   ✅ 도착역: %s\n", job.To)
+		fmt.Println()
+	}
+
+	// 시간 정보 입력
+	printSubHeader("⏰ 시간 정보")
+
+	// 출발 날짜 입력 (--date로 정했으면 생략)
+	if job.Date == "" {
+		// 현재 연도 자동 설정
+		currentYear := time.Now().Year()
+		fmt.Printf("   📅 출발 연도: %d (자동 설정)\n", currentYear)
+
+		// 출발 월 입력
+		monthStr := getInputWithValidation(
+			"출발 월을 입력하세요 (1~12)",
+			"",
+			validateMonth,
+			"6",
+		)
+
+		// 출발 일 입력
+		dayStr := getInputWithValidation(
+			"출발 일을 입력하세요 (1~31)",
+			"",
+			validateDay,
+			"22",
+		)
+
+		// YYYYMMDD 형식으로 조합
+		month, _ := strconv.Atoi(monthStr)
+		day, _ := strconv.Atoi(dayStr)
+		job.Date = fmt.Sprintf("%04d%02d%02d", currentYear, month, day)
+
+		fmt.Printf("   ✅ 출발날짜: %s (%d년 %d월 %d일)\n", job.Date, currentYear, month, day)
+	} else {
+		fmt.Printf("   ✅ 출발날짜: %s\n", job.Date)
+	}
+
+	// 출발/도착시간 입력 (경로 프리셋이나 --dept-time/--arrival-time으로 정했으면 생략)
+	if job.DeptTime == "" || job.ArrivalTime == "" {
+		// 출발시간 입력 (4자리 숫자로 입력받아 HH:MM 형식으로 변환)
+		deptTimeStr := getInputWithValidation(
+			"출발시간을 입력하세요 (4자리 숫자)",
+			"",
+			validateTime,
+			"1037",
+		)
+
+		// HHMM → HH:MM 형식으로 변환
+		deptHour := deptTimeStr[:2]
+		deptMinute := deptTimeStr[2:]
+		job.DeptTime = fmt.Sprintf("%s:%s", deptHour, deptMinute)
+
+		fmt.Printf("   ✅ 출발시간: %s\n", job.DeptTime)
+
+		// 도착시간 입력 (4자리 숫자로 입력받아 HH:MM 형식으로 변환)
+		arrivalTimeStr := getInputWithValidation(
+			"도착시간을 입력하세요 (4자리 숫자)",
+			"",
+			validateTime,
+			"1207",
+		)
+
+		// HHMM → HH:MM 형식으로 변환
+		arrivalHour := arrivalTimeStr[:2]
+		arrivalMinute := arrivalTimeStr[2:]
+		job.ArrivalTime = fmt.Sprintf("%s:%s", arrivalHour, arrivalMinute)
+
+		fmt.Printf("   ✅ 도착시간: %s\n", job.ArrivalTime)
+	}
+
+	// 예약자 정보 입력 (미등록 고객만)
+	if job.Mode == "watch" {
+		fmt.Println()
+		fmt.Println("   ℹ️ 감시 모드는 예약을 진행하지 않으므로 예약자/로그인 정보가 필요 없어요")
+	} else if job.Credential != "" {
+		fmt.Println()
+		fmt.Println("   ℹ️ 보관함의 자격 증명을 사용하므로 예약자/로그인 정보 입력을 건너뛰어요")
+	} else if job.CustomerType == "unregistered" {
+		printSubHeader("👤 예약자 정보")
+		if presets.profileDetails && job.Phone != "" {
+			fmt.Printf("   ✅ 프로필의 예약자 정보를 사용해요: %s (%s)\n", job.Name, redact(job.Phone))
+		} else {
+			job.Name = getInputWithValidation(
+				"예약자 이름을 입력하세요",
+				"",
+				func(s string) bool { return validateRequired(s, "예약자 이름") },
+				"홍길동",
+			)
+
+			job.Phone = getInputWithValidation(
+				"전화번호를 입력하세요 (숫자만)",
+				"",
+				func(s string) bool { return validateRequired(s, "전화번호") && validatePhone(s) },
+				"01012345678",
+			)
+		}
+
+		// 비밀번호 입력
+		printSubHeader("🔐 비밀번호 설정")
+		for {
+			job.Password = getPasswordInput("비밀번호를 입력하세요 (5자리 숫자)")
+			if validatePassword(job.Password) {
+				break
+			}
+		}
+	} else {
+		fmt.Println()
+		fmt.Println("   ℹ️ 로그인 고객 예매는 회원 정보를 사용하므로 별도 입력이 불필요해요")
+
+		// 로그인 정보 입력
+		printSubHeader("🔐 로그인 정보")
+		fmt.Println("   로그인 타입을 선택하세요:")
+		fmt.Println("   1. 회원번호로 로그인")
+		fmt.Println("   2. 이메일로 로그인")
+		fmt.Println("   3. 전화번호로 로그인")
+		fmt.Println()
+
+		for {
+			loginChoice := getUserInput("로그인 타입을 선택하세요 (1, 2, 3)", "1")
+			switch loginChoice {
+			case "1":
+				job.LoginType = "member"
+				fmt.Println("   ✅ 회원번호 로그인을 선택했어요")
+				job.LoginID = getInputWithValidation(
+					"회원번호를 입력하세요",
+					"",
+					func(s string) bool { return validateRequired(s, "회원번호") },
+					"1234567890",
+				)
+				break
+			case "2":
+				job.LoginType = "email"
+				fmt.Println("   ✅ 이메일 로그인을 선택했어요")
+				job.LoginID = getInputWithValidation(
+					"이메일을 입력하세요",
+					"",
+					func(s string) bool { return validateRequired(s, "이메일") && validateEmail(s) },
+					"example@gmail.com",
+				)
+				break
+			case "3":
+				job.LoginType = "phone"
+				fmt.Println("   ✅ 전화번호 로그인을 선택했어요")
+				job.LoginID = getInputWithValidation(
+					"전화번호를 입력하세요 (숫자만)",
+					"",
+					func(s string) bool { return validateRequired(s, "전화번호") && validatePhone(s) },
+					"01012345678",
+				)
+				break
+			default:
+				fmt.Println("   ❌ 1, 2, 3 중 하나를 입력해주세요")
+				fmt.Println()
+				continue
+			}
+			break
+		}
+
+		for {
+			job.LoginPassword = getPasswordInput("로그인 비밀번호를 입력하세요")
+			if validateRequired(job.LoginPassword, "로그인 비밀번호") {
+				break
+			}
+		}
+	}
+
+	// 알림 설정 (승객 프로필에 기본 알림 주소가 있으면 그대로 사용)
+	printSubHeader("📧 알림 설정")
+	if presets.profileNotify {
+		fmt.Printf("   ✅ 프로필의 알림 주소를 사용해요: %s\n", redact(strings.Join(job.NotifyEmails, ", ")))
+	} else {
+		var wantsEmail bool
+		if job.Mode == "watch" {
+			wantsEmail = getYesNoInput("빈자리 발견 시 이메일 알림을 받으시겠습니까?", true)
+		} else {
+			wantsEmail = getYesNoInput("예약 완료 시 이메일 알림을 받으시겠습니까?", false)
+		}
+
+		job.NotifyEmails = nil
+		if wantsEmail {
+			emails := getInputWithValidation(
+				"알림받을 이메일 주소를 입력하세요 (여러 개는 쉼표로 구분)",
+				"",
+				func(s string) bool { return validateRequired(s, "이메일 주소") && validateEmailList(s) },
+				"me@gmail.com, friend@gmail.com",
+			)
+			job.NotifyEmails = splitList(emails)
+		}
+	}
+
+	// 입력 정보 확인
+	printSubHeader("✅ 입력 정보 확인")
+	fmt.Printf("    실행 모드: %s\n",
+		map[string]string{
+			"reserve": "자동 예약",
+			"watch":   "빈자리 감시",
+		}[job.Mode])
+	if job.Mode == "reserve" {
+		fmt.Printf("    고객 유형: %s\n",
+			map[string]string{
+				"unregistered": "미등록 고객 예매",
+				"login":        "로그인 고객 예매",
+			}[job.CustomerType])
+	}
+	fmt.Printf("    출발역: %s (%s)\n", job.From, job.DeptTime)
+	fmt.Printf("    도착역: %s (%s)\n", job.To, job.ArrivalTime)
+	fmt.Printf("    날짜: %s\n", job.Date)
+
+	if job.Mode == "reserve" && job.CustomerType == "unregistered" {
+		fmt.Printf("    예약자: %s\n", job.Name)
+		fmt.Printf("    전화번호: %s\n", redact(job.Phone))
+	} else if job.Mode == "reserve" {
+		loginTypeMap := map[string]string{
+			"member": "회원번호",
+			"email":  "이메일",
+			"phone":  "전화번호",
+		}
+		fmt.Printf("    로그인 타입: %s\n", loginTypeMap[job.LoginType])
+		fmt.Printf("    로그인 ID: %s\n", redactLoginID(job.LoginType, job.LoginID))
+	}
+
+	if len(job.NotifyEmails) > 0 {
+		fmt.Printf("    알림 이메일: %s\n", redact(strings.Join(job.NotifyEmails, ", ")))
+	}
+	fmt.Println()
+
+	if !getYesNoInput("위 정보가 맞습니까?", true) {
+		fmt.Println("   🔄 정보를 다시 입력할게요")
+		// 다시 입력할 때는 프로필과 명령행으로 정한 여정도 처음부터 다시 고를 수 있게 되돌림
+		if presets.profileCredential {
+			job.Credential = ""
+		}
+		runOptions.passenger, runOptions.route = "", ""
+		runOptions.from, runOptions.to, runOptions.date = "", "", ""
+		runOptions.deptTime, runOptions.arrivalTime = "", ""
+		job.From, job.To, job.Date = "", "", ""
+		job.DeptTime, job.ArrivalTime = "", ""
+		collectUserInput(job)
+		return
+	}
+
+	fmt.Println("   ✅ 정보 확인 완료! 예약을 시작할게요")
+	fmt.Println()
+}